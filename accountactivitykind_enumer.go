@@ -0,0 +1,126 @@
+// Code generated by "enumer -type AccountActivityKind -trimprefix AccountActivityKind"; DO NOT EDIT.
+
+package td
+
+import (
+	"fmt"
+	"strings"
+)
+
+const _AccountActivityKindName = "UnspecifiedSubscribedOrderCreatedOrderAcceptedOrderRejectedExecutionRequestedExecutionPartiallyFilledFilledCancelRequestedCanceledReplaceRequestedReplacedUnknown"
+
+var _AccountActivityKindIndex = [...]uint8{0, 11, 21, 33, 46, 59, 77, 86, 101, 107, 122, 130, 146, 154, 161}
+
+const _AccountActivityKindLowerName = "unspecifiedsubscribedordercreatedorderacceptedorderrejectedexecutionrequestedexecutionpartiallyfilledfilledcancelrequestedcanceledreplacerequestedreplacedunknown"
+
+func (i AccountActivityKind) String() string {
+	if i >= AccountActivityKind(len(_AccountActivityKindIndex)-1) {
+		return fmt.Sprintf("AccountActivityKind(%d)", i)
+	}
+	return _AccountActivityKindName[_AccountActivityKindIndex[i]:_AccountActivityKindIndex[i+1]]
+}
+
+// An "invalid array index" compiler error signifies that the constant values have changed.
+// Re-run the stringer command to generate them again.
+func _AccountActivityKindNoOp() {
+	var x [1]struct{}
+	_ = x[AccountActivityKindUnspecified-(0)]
+	_ = x[AccountActivityKindSubscribed-(1)]
+	_ = x[AccountActivityKindOrderCreated-(2)]
+	_ = x[AccountActivityKindOrderAccepted-(3)]
+	_ = x[AccountActivityKindOrderRejected-(4)]
+	_ = x[AccountActivityKindExecutionRequested-(5)]
+	_ = x[AccountActivityKindExecution-(6)]
+	_ = x[AccountActivityKindPartiallyFilled-(7)]
+	_ = x[AccountActivityKindFilled-(8)]
+	_ = x[AccountActivityKindCancelRequested-(9)]
+	_ = x[AccountActivityKindCanceled-(10)]
+	_ = x[AccountActivityKindReplaceRequested-(11)]
+	_ = x[AccountActivityKindReplaced-(12)]
+	_ = x[AccountActivityKindUnknown-(13)]
+}
+
+var _AccountActivityKindValues = []AccountActivityKind{AccountActivityKindUnspecified, AccountActivityKindSubscribed, AccountActivityKindOrderCreated, AccountActivityKindOrderAccepted, AccountActivityKindOrderRejected, AccountActivityKindExecutionRequested, AccountActivityKindExecution, AccountActivityKindPartiallyFilled, AccountActivityKindFilled, AccountActivityKindCancelRequested, AccountActivityKindCanceled, AccountActivityKindReplaceRequested, AccountActivityKindReplaced, AccountActivityKindUnknown}
+
+var _AccountActivityKindNameToValueMap = map[string]AccountActivityKind{
+	_AccountActivityKindName[0:11]:         AccountActivityKindUnspecified,
+	_AccountActivityKindLowerName[0:11]:    AccountActivityKindUnspecified,
+	_AccountActivityKindName[11:21]:        AccountActivityKindSubscribed,
+	_AccountActivityKindLowerName[11:21]:   AccountActivityKindSubscribed,
+	_AccountActivityKindName[21:33]:        AccountActivityKindOrderCreated,
+	_AccountActivityKindLowerName[21:33]:   AccountActivityKindOrderCreated,
+	_AccountActivityKindName[33:46]:        AccountActivityKindOrderAccepted,
+	_AccountActivityKindLowerName[33:46]:   AccountActivityKindOrderAccepted,
+	_AccountActivityKindName[46:59]:        AccountActivityKindOrderRejected,
+	_AccountActivityKindLowerName[46:59]:   AccountActivityKindOrderRejected,
+	_AccountActivityKindName[59:77]:        AccountActivityKindExecutionRequested,
+	_AccountActivityKindLowerName[59:77]:   AccountActivityKindExecutionRequested,
+	_AccountActivityKindName[77:86]:        AccountActivityKindExecution,
+	_AccountActivityKindLowerName[77:86]:   AccountActivityKindExecution,
+	_AccountActivityKindName[86:101]:       AccountActivityKindPartiallyFilled,
+	_AccountActivityKindLowerName[86:101]:  AccountActivityKindPartiallyFilled,
+	_AccountActivityKindName[101:107]:      AccountActivityKindFilled,
+	_AccountActivityKindLowerName[101:107]: AccountActivityKindFilled,
+	_AccountActivityKindName[107:122]:      AccountActivityKindCancelRequested,
+	_AccountActivityKindLowerName[107:122]: AccountActivityKindCancelRequested,
+	_AccountActivityKindName[122:130]:      AccountActivityKindCanceled,
+	_AccountActivityKindLowerName[122:130]: AccountActivityKindCanceled,
+	_AccountActivityKindName[130:146]:      AccountActivityKindReplaceRequested,
+	_AccountActivityKindLowerName[130:146]: AccountActivityKindReplaceRequested,
+	_AccountActivityKindName[146:154]:      AccountActivityKindReplaced,
+	_AccountActivityKindLowerName[146:154]: AccountActivityKindReplaced,
+	_AccountActivityKindName[154:161]:      AccountActivityKindUnknown,
+	_AccountActivityKindLowerName[154:161]: AccountActivityKindUnknown,
+}
+
+var _AccountActivityKindNames = []string{
+	_AccountActivityKindName[0:11],
+	_AccountActivityKindName[11:21],
+	_AccountActivityKindName[21:33],
+	_AccountActivityKindName[33:46],
+	_AccountActivityKindName[46:59],
+	_AccountActivityKindName[59:77],
+	_AccountActivityKindName[77:86],
+	_AccountActivityKindName[86:101],
+	_AccountActivityKindName[101:107],
+	_AccountActivityKindName[107:122],
+	_AccountActivityKindName[122:130],
+	_AccountActivityKindName[130:146],
+	_AccountActivityKindName[146:154],
+	_AccountActivityKindName[154:161],
+}
+
+// AccountActivityKindString retrieves an enum value from the enum constants string name.
+// Throws an error if the param is not part of the enum.
+func AccountActivityKindString(s string) (AccountActivityKind, error) {
+	if val, ok := _AccountActivityKindNameToValueMap[s]; ok {
+		return val, nil
+	}
+
+	if val, ok := _AccountActivityKindNameToValueMap[strings.ToLower(s)]; ok {
+		return val, nil
+	}
+	return 0, fmt.Errorf("%s does not belong to AccountActivityKind values", s)
+}
+
+// AccountActivityKindValues returns all values of the enum
+func AccountActivityKindValues() []AccountActivityKind {
+	return _AccountActivityKindValues
+}
+
+// AccountActivityKindStrings returns a slice of all String values of the enum
+func AccountActivityKindStrings() []string {
+	strs := make([]string, len(_AccountActivityKindNames))
+	copy(strs, _AccountActivityKindNames)
+	return strs
+}
+
+// IsAAccountActivityKind returns "true" if the value is listed in the enum definition. "false" otherwise
+func (i AccountActivityKind) IsAAccountActivityKind() bool {
+	for _, v := range _AccountActivityKindValues {
+		if i == v {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,259 @@
+package td
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+var (
+	ErrMissingAcctHash = errors.New("missing account hash")
+)
+
+//go:generate enumer -type AccountField -trimprefix AccountField -transform lower
+type AccountField byte
+
+const (
+	AccountFieldUnspecified AccountField = iota
+	AccountFieldPositions
+)
+
+//go:generate enumer -type AccountType -trimprefix AccountType -json -transform upper
+type AccountType byte
+
+const (
+	AccountTypeUnspecified AccountType = iota
+	AccountTypeCash
+	AccountTypeMargin
+)
+
+// AccountNumber pairs the plain account number with the hash Schwab
+// requires in the path of every account specific endpoint
+type AccountNumber struct {
+	AccountNumber string `json:"accountNumber"`
+	HashValue     string `json:"hashValue"`
+}
+
+type Account struct {
+	SecuritiesAccount SecuritiesAccount `json:"securitiesAccount"`
+	AggregatedBalance AggregatedBalance `json:"aggregatedBalance"`
+}
+
+type AggregatedBalance struct {
+	CurrentLiquidationValue float64 `json:"currentLiquidationValue"`
+	LiquidationValue        float64 `json:"liquidationValue"`
+}
+
+type SecuritiesAccount struct {
+	Type                    AccountType `json:"type"`
+	AccountNumber           string      `json:"accountNumber"`
+	RoundTrips              int         `json:"roundTrips"`
+	IsDayTrader             bool        `json:"isDayTrader"`
+	IsClosingOnlyRestricted bool        `json:"isClosingOnlyRestricted"`
+	PFCBFlag                bool        `json:"pfcbFlag"`
+	Positions               []Position  `json:"positions"` // Only populated when requested with AccountFieldPositions
+	InitialBalances         Balances    `json:"initialBalances"`
+	CurrentBalances         Balances    `json:"currentBalances"`
+	ProjectedBalances       Balances    `json:"projectedBalances"`
+}
+
+// Balances holds every balance Schwab can send. Cash and margin accounts
+// each only send a subset, and initial, current and projected balances
+// differ again, so anything not sent is left zero
+type Balances struct {
+	AccountValue                     float64 `json:"accountValue"`
+	AccruedInterest                  float64 `json:"accruedInterest"`
+	AvailableFunds                   float64 `json:"availableFunds"`
+	AvailableFundsNonMarginableTrade float64 `json:"availableFundsNonMarginableTrade"`
+	BondValue                        float64 `json:"bondValue"`
+	BuyingPower                      float64 `json:"buyingPower"`
+	BuyingPowerNonMarginableTrade    float64 `json:"buyingPowerNonMarginableTrade"`
+	CashAvailableForTrading          float64 `json:"cashAvailableForTrading"`
+	CashAvailableForWithdrawal       float64 `json:"cashAvailableForWithdrawal"`
+	CashBalance                      float64 `json:"cashBalance"`
+	CashCall                         float64 `json:"cashCall"`
+	CashDebitCallValue               float64 `json:"cashDebitCallValue"`
+	CashReceipts                     float64 `json:"cashReceipts"`
+	DayTradingBuyingPower            float64 `json:"dayTradingBuyingPower"`
+	DayTradingBuyingPowerCall        float64 `json:"dayTradingBuyingPowerCall"`
+	DayTradingEquityCall             float64 `json:"dayTradingEquityCall"`
+	Equity                           float64 `json:"equity"`
+	EquityPercentage                 float64 `json:"equityPercentage"`
+	IsInCall                         bool    `json:"isInCall"`
+	LiquidationValue                 float64 `json:"liquidationValue"`
+	LongMarginValue                  float64 `json:"longMarginValue"`
+	LongMarketValue                  float64 `json:"longMarketValue"`
+	LongNonMarginableMarketValue     float64 `json:"longNonMarginableMarketValue"`
+	LongOptionMarketValue            float64 `json:"longOptionMarketValue"`
+	LongStockValue                   float64 `json:"longStockValue"`
+	MaintenanceCall                  float64 `json:"maintenanceCall"`
+	MaintenanceRequirement           float64 `json:"maintenanceRequirement"`
+	Margin                           float64 `json:"margin"`
+	MarginBalance                    float64 `json:"marginBalance"`
+	MarginEquity                     float64 `json:"marginEquity"`
+	MoneyMarketFund                  float64 `json:"moneyMarketFund"`
+	MutualFundValue                  float64 `json:"mutualFundValue"`
+	OptionBuyingPower                float64 `json:"optionBuyingPower"`
+	PendingDeposits                  float64 `json:"pendingDeposits"`
+	RegTCall                         float64 `json:"regTCall"`
+	Savings                          float64 `json:"savings"`
+	ShortBalance                     float64 `json:"shortBalance"`
+	ShortMarginValue                 float64 `json:"shortMarginValue"`
+	ShortMarketValue                 float64 `json:"shortMarketValue"`
+	ShortOptionMarketValue           float64 `json:"shortOptionMarketValue"`
+	ShortStockValue                  float64 `json:"shortStockValue"`
+	SMA                              float64 `json:"sma"`
+	StockBuyingPower                 float64 `json:"stockBuyingPower"`
+	TotalCash                        float64 `json:"totalCash"`
+	UnsettledCash                    float64 `json:"unsettledCash"`
+}
+
+type Position struct {
+	Instrument                     Instrument `json:"instrument"`
+	LongQuantity                   float64    `json:"longQuantity"`
+	ShortQuantity                  float64    `json:"shortQuantity"`
+	SettledLongQuantity            float64    `json:"settledLongQuantity"`
+	SettledShortQuantity           float64    `json:"settledShortQuantity"`
+	AgedQuantity                   float64    `json:"agedQuantity"`
+	PreviousSessionLongQuantity    float64    `json:"previousSessionLongQuantity"`
+	PreviousSessionShortQuantity   float64    `json:"previousSessionShortQuantity"`
+	AveragePrice                   float64    `json:"averagePrice"`
+	AverageLongPrice               float64    `json:"averageLongPrice"`
+	AverageShortPrice              float64    `json:"averageShortPrice"`
+	TaxLotAverageLongPrice         float64    `json:"taxLotAverageLongPrice"`
+	TaxLotAverageShortPrice        float64    `json:"taxLotAverageShortPrice"`
+	MarketValue                    float64    `json:"marketValue"`
+	MaintenanceRequirement         float64    `json:"maintenanceRequirement"`
+	CurrentDayProfitLoss           float64    `json:"currentDayProfitLoss"`
+	CurrentDayProfitLossPercentage float64    `json:"currentDayProfitLossPercentage"`
+	CurrentDayCost                 float64    `json:"currentDayCost"`
+	LongOpenProfitLoss             float64    `json:"longOpenProfitLoss"`
+	ShortOpenProfitLoss            float64    `json:"shortOpenProfitLoss"`
+}
+
+// Instrument is any of the instruments Schwab can put in an account or transaction: equities,
+// options, mutual funds, fixed income and cash equivalents. Fields that don't apply
+// to the AssetType are left zero
+type Instrument struct {
+	AssetType    AssetType `json:"assetType,omitzero"`
+	InstrumentID int64     `json:"instrumentId,omitzero"`
+	Cusip        string    `json:"cusip,omitzero"`
+	Symbol       string    `json:"symbol,omitzero"`
+	Description  string    `json:"description,omitzero"`
+	NetChange    float64   `json:"netChange,omitzero"`
+	ClosingPrice float64   `json:"closingPrice,omitzero"`
+	Status       string    `json:"status,omitzero"` // ACTIVE or INACTIVE; only sent in transactions
+
+	// Sub type as Schwab sent it. Cash equivalents send values like MONEY_MARKET_FUND,
+	// options send VANILLA, BINARY or BARRIER
+	Type string `json:"type,omitzero"`
+
+	// Options
+	UnderlyingSymbol        string              `json:"underlyingSymbol,omitzero"`
+	PutCall                 OptionSide          `json:"-"`
+	UnderlyingCusip         string              `json:"underlyingCusip,omitzero"`
+	StrikePrice             float64             `json:"strikePrice,omitzero"`
+	ExpirationDate          time.Time           `json:"-"`
+	OptionMultiplier        float64             `json:"optionMultiplier,omitzero"`
+	OptionPremiumMultiplier float64             `json:"optionPremiumMultiplier,omitzero"`
+	OptionDeliverables      []OptionDeliverable `json:"optionDeliverables,omitzero"`
+
+	// Fixed income
+	MaturityDate time.Time `json:"-"`
+	Factor       float64   `json:"factor,omitzero"`
+	VariableRate float64   `json:"variableRate,omitzero"`
+}
+
+type OptionDeliverable struct {
+	Symbol           string    `json:"symbol,omitzero"`
+	DeliverableUnits float64   `json:"deliverableUnits,omitzero"`
+	AssetType        AssetType `json:"assetType,omitzero"`
+}
+
+func (i *Instrument) UnmarshalJSON(b []byte) error {
+	type instrument Instrument
+	type wrapper struct {
+		*instrument
+		PutCall        string    `json:"putCall"`
+		ExpirationDate orderTime `json:"expirationDate"`
+		MaturityDate   orderTime `json:"maturityDate"`
+	}
+
+	x := wrapper{instrument: (*instrument)(i)}
+	if err := json.Unmarshal(b, &x); err != nil {
+		return err
+	}
+
+	i.ExpirationDate = time.Time(x.ExpirationDate)
+	i.MaturityDate = time.Time(x.MaturityDate)
+
+	switch x.PutCall {
+	case "":
+		i.PutCall = OptionSideUnspecified
+	case "CALL":
+		i.PutCall = OptionSideCall
+	case "PUT":
+		i.PutCall = OptionSidePut
+	default:
+		return fmt.Errorf("%w: got %s", ErrInvalidSide, x.PutCall)
+	}
+
+	return nil
+}
+
+// GetAccountNumbers returns every account number the user has alongside its hash value.
+// The hash is what every other account endpoint takes, the plain account number isn't accepted
+func (c *HTTPClient) GetAccountNumbers(ctx context.Context) ([]AccountNumber, error) {
+	var a []AccountNumber
+	if err := c.do(ctx, http.MethodGet, "/accounts/accountNumbers", nil, &a); err != nil {
+		return nil, err
+	}
+
+	return a, nil
+}
+
+// GetAccounts returns balances for every linked account. Pass AccountFieldPositions
+// to also get the positions held in each
+func (c *HTTPClient) GetAccounts(ctx context.Context, fields ...AccountField) ([]Account, error) {
+	var a []Account
+	if err := c.do(ctx, http.MethodGet, "/accounts"+accountFieldsQuery(fields), nil, &a); err != nil {
+		return nil, err
+	}
+
+	return a, nil
+}
+
+// GetAccount returns balances for a single account by its hash value; see GetAccountNumbers.
+// Pass AccountFieldPositions to also get the positions held
+func (c *HTTPClient) GetAccount(ctx context.Context, hash string, fields ...AccountField) (*Account, error) {
+	if hash == "" {
+		c.logger.ErrorContext(ctx, "missing account hash")
+		return nil, ErrMissingAcctHash
+	}
+
+	a := new(Account)
+	err := c.do(ctx, http.MethodGet, fmt.Sprintf("/accounts/%s%s", url.PathEscape(hash), accountFieldsQuery(fields)), nil, a)
+	if err != nil {
+		return nil, err
+	}
+
+	return a, nil
+}
+
+func accountFieldsQuery(fields []AccountField) string {
+	if len(fields) == 0 {
+		return ""
+	}
+
+	s := make([]string, len(fields))
+	for i, v := range fields {
+		s[i] = v.String()
+	}
+
+	return "?fields=" + strings.Join(s, ",")
+}
@@ -0,0 +1,114 @@
+package td
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+// mockHTTP serves a canned response body for a single path and query, on both the trader and market data APIs
+func mockHTTP(t *testing.T, path, query, body string) *HTTPClient {
+	t.Helper()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != path || r.URL.RawQuery != query {
+			t.Errorf("want request to %s?%s, got %s", path, query, r.URL.RequestURI())
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(body))
+	}))
+	t.Cleanup(srv.Close)
+
+	return &HTTPClient{
+		baseURL:   srv.URL,
+		marketURL: srv.URL,
+		http:      srv.Client(),
+		logger:    slog.New(slog.DiscardHandler),
+	}
+}
+
+func TestGetAccountNumbers(t *testing.T) {
+	c := mockHTTP(t, "/accounts/accountNumbers", "", `[{"accountNumber":"12345678","hashValue":"ABCDEF"}]`)
+
+	got, err := c.GetAccountNumbers(context.Background())
+	if err != nil {
+		t.Fatalf("should not fail, got %s", err)
+	}
+
+	if want := []AccountNumber{{AccountNumber: "12345678", HashValue: "ABCDEF"}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("want %+v, got %+v", want, got)
+	}
+}
+
+func TestGetAccount(t *testing.T) {
+	c := mockHTTP(t, "/accounts/ABCDEF", "fields=positions", `{
+"securitiesAccount":{"type":"MARGIN","accountNumber":"12345678","roundTrips":1,"isDayTrader":false,
+	"positions":[
+		{"shortQuantity":0,"averagePrice":150.25,"longQuantity":10,"marketValue":1600,
+			"instrument":{"assetType":"EQUITY","cusip":"037833100","symbol":"AAPL","instrumentId":1973757747,"netChange":1.5}},
+		{"shortQuantity":1,"averagePrice":2.5,"longQuantity":0,"marketValue":-300,
+			"instrument":{"assetType":"OPTION","cusip":"0AAPL.AF50200000","symbol":"AAPL  250117C00200000","putCall":"CALL","type":"VANILLA","underlyingSymbol":"AAPL"}}
+	],
+	"initialBalances":{"accountValue":10000,"buyingPower":20000,"isInCall":false},
+	"currentBalances":{"availableFunds":5000,"sma":1000},
+	"projectedBalances":{"availableFunds":5000}},
+"aggregatedBalance":{"currentLiquidationValue":10000,"liquidationValue":10000}}`)
+
+	if _, err := c.GetAccount(context.Background(), ""); err != ErrMissingAcctHash {
+		t.Errorf("missing hash should fail with %s, got %v", ErrMissingAcctHash, err)
+	}
+
+	got, err := c.GetAccount(context.Background(), "ABCDEF", AccountFieldPositions)
+	if err != nil {
+		t.Fatalf("should not fail, got %s", err)
+	}
+
+	want := &Account{
+		SecuritiesAccount: SecuritiesAccount{
+			Type:          AccountTypeMargin,
+			AccountNumber: "12345678",
+			RoundTrips:    1,
+			Positions: []Position{
+				{
+					AveragePrice: 150.25,
+					LongQuantity: 10,
+					MarketValue:  1600,
+					Instrument: Instrument{
+						AssetType:    AssetTypeEquity,
+						Cusip:        "037833100",
+						Symbol:       "AAPL",
+						InstrumentID: 1973757747,
+						NetChange:    1.5,
+					},
+				},
+				{
+					ShortQuantity: 1,
+					AveragePrice:  2.5,
+					MarketValue:   -300,
+					Instrument: Instrument{
+						AssetType:        AssetTypeOption,
+						Cusip:            "0AAPL.AF50200000",
+						Symbol:           "AAPL  250117C00200000",
+						PutCall:          OptionSideCall,
+						Type:             "VANILLA",
+						UnderlyingSymbol: "AAPL",
+					},
+				},
+			},
+			InitialBalances:   Balances{AccountValue: 10000, BuyingPower: 20000},
+			CurrentBalances:   Balances{AvailableFunds: 5000, SMA: 1000},
+			ProjectedBalances: Balances{AvailableFunds: 5000},
+		},
+		AggregatedBalance: AggregatedBalance{CurrentLiquidationValue: 10000, LiquidationValue: 10000},
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("want %+v\ngot  %+v", want, got)
+	}
+}
@@ -8,11 +8,11 @@ import (
 	"strings"
 )
 
-const _AssetTypeName = "UNSPECIFIEDBONDEQUITYETFEXTENDEDFOREXFUTUREFUTURE_OPTIONFUNDAMENTALINDEXINDICATORMUTUAL_FUNDOPTIONUNKNOWN"
+const _AssetTypeName = "UNSPECIFIEDBONDEQUITYETFEXTENDEDFOREXFUTUREFUTURE_OPTIONFUNDAMENTALINDEXINDICATORMUTUAL_FUNDOPTIONUNKNOWNCASH_EQUIVALENTFIXED_INCOMECURRENCYCOLLECTIVE_INVESTMENTPRODUCT"
 
-var _AssetTypeIndex = [...]uint8{0, 11, 15, 21, 24, 32, 37, 43, 56, 67, 72, 81, 92, 98, 105}
+var _AssetTypeIndex = [...]uint8{0, 11, 15, 21, 24, 32, 37, 43, 56, 67, 72, 81, 92, 98, 105, 120, 132, 140, 161, 168}
 
-const _AssetTypeLowerName = "unspecifiedbondequityetfextendedforexfuturefuture_optionfundamentalindexindicatormutual_fundoptionunknown"
+const _AssetTypeLowerName = "unspecifiedbondequityetfextendedforexfuturefuture_optionfundamentalindexindicatormutual_fundoptionunknowncash_equivalentfixed_incomecurrencycollective_investmentproduct"
 
 func (i AssetType) String() string {
 	if i >= AssetType(len(_AssetTypeIndex)-1) {
@@ -39,39 +39,54 @@ func _AssetTypeNoOp() {
 	_ = x[AssetTypeMutualFund-(11)]
 	_ = x[AssetTypeOption-(12)]
 	_ = x[AssetTypeUnknown-(13)]
+	_ = x[AssetTypeCashEquivalent-(14)]
+	_ = x[AssetTypeFixedIncome-(15)]
+	_ = x[AssetTypeCurrency-(16)]
+	_ = x[AssetTypeCollectiveInvestment-(17)]
+	_ = x[AssetTypeProduct-(18)]
 }
 
-var _AssetTypeValues = []AssetType{AssetTypeUnspecified, AssetTypeBond, AssetTypeEquity, AssetTypeEtf, AssetTypeExtended, AssetTypeForex, AssetTypeFuture, AssetTypeFutureOption, AssetTypeFundamental, AssetTypeIndex, AssetTypeIndicator, AssetTypeMutualFund, AssetTypeOption, AssetTypeUnknown}
+var _AssetTypeValues = []AssetType{AssetTypeUnspecified, AssetTypeBond, AssetTypeEquity, AssetTypeEtf, AssetTypeExtended, AssetTypeForex, AssetTypeFuture, AssetTypeFutureOption, AssetTypeFundamental, AssetTypeIndex, AssetTypeIndicator, AssetTypeMutualFund, AssetTypeOption, AssetTypeUnknown, AssetTypeCashEquivalent, AssetTypeFixedIncome, AssetTypeCurrency, AssetTypeCollectiveInvestment, AssetTypeProduct}
 
 var _AssetTypeNameToValueMap = map[string]AssetType{
-	_AssetTypeName[0:11]:        AssetTypeUnspecified,
-	_AssetTypeLowerName[0:11]:   AssetTypeUnspecified,
-	_AssetTypeName[11:15]:       AssetTypeBond,
-	_AssetTypeLowerName[11:15]:  AssetTypeBond,
-	_AssetTypeName[15:21]:       AssetTypeEquity,
-	_AssetTypeLowerName[15:21]:  AssetTypeEquity,
-	_AssetTypeName[21:24]:       AssetTypeEtf,
-	_AssetTypeLowerName[21:24]:  AssetTypeEtf,
-	_AssetTypeName[24:32]:       AssetTypeExtended,
-	_AssetTypeLowerName[24:32]:  AssetTypeExtended,
-	_AssetTypeName[32:37]:       AssetTypeForex,
-	_AssetTypeLowerName[32:37]:  AssetTypeForex,
-	_AssetTypeName[37:43]:       AssetTypeFuture,
-	_AssetTypeLowerName[37:43]:  AssetTypeFuture,
-	_AssetTypeName[43:56]:       AssetTypeFutureOption,
-	_AssetTypeLowerName[43:56]:  AssetTypeFutureOption,
-	_AssetTypeName[56:67]:       AssetTypeFundamental,
-	_AssetTypeLowerName[56:67]:  AssetTypeFundamental,
-	_AssetTypeName[67:72]:       AssetTypeIndex,
-	_AssetTypeLowerName[67:72]:  AssetTypeIndex,
-	_AssetTypeName[72:81]:       AssetTypeIndicator,
-	_AssetTypeLowerName[72:81]:  AssetTypeIndicator,
-	_AssetTypeName[81:92]:       AssetTypeMutualFund,
-	_AssetTypeLowerName[81:92]:  AssetTypeMutualFund,
-	_AssetTypeName[92:98]:       AssetTypeOption,
-	_AssetTypeLowerName[92:98]:  AssetTypeOption,
-	_AssetTypeName[98:105]:      AssetTypeUnknown,
-	_AssetTypeLowerName[98:105]: AssetTypeUnknown,
+	_AssetTypeName[0:11]:         AssetTypeUnspecified,
+	_AssetTypeLowerName[0:11]:    AssetTypeUnspecified,
+	_AssetTypeName[11:15]:        AssetTypeBond,
+	_AssetTypeLowerName[11:15]:   AssetTypeBond,
+	_AssetTypeName[15:21]:        AssetTypeEquity,
+	_AssetTypeLowerName[15:21]:   AssetTypeEquity,
+	_AssetTypeName[21:24]:        AssetTypeEtf,
+	_AssetTypeLowerName[21:24]:   AssetTypeEtf,
+	_AssetTypeName[24:32]:        AssetTypeExtended,
+	_AssetTypeLowerName[24:32]:   AssetTypeExtended,
+	_AssetTypeName[32:37]:        AssetTypeForex,
+	_AssetTypeLowerName[32:37]:   AssetTypeForex,
+	_AssetTypeName[37:43]:        AssetTypeFuture,
+	_AssetTypeLowerName[37:43]:   AssetTypeFuture,
+	_AssetTypeName[43:56]:        AssetTypeFutureOption,
+	_AssetTypeLowerName[43:56]:   AssetTypeFutureOption,
+	_AssetTypeName[56:67]:        AssetTypeFundamental,
+	_AssetTypeLowerName[56:67]:   AssetTypeFundamental,
+	_AssetTypeName[67:72]:        AssetTypeIndex,
+	_AssetTypeLowerName[67:72]:   AssetTypeIndex,
+	_AssetTypeName[72:81]:        AssetTypeIndicator,
+	_AssetTypeLowerName[72:81]:   AssetTypeIndicator,
+	_AssetTypeName[81:92]:        AssetTypeMutualFund,
+	_AssetTypeLowerName[81:92]:   AssetTypeMutualFund,
+	_AssetTypeName[92:98]:        AssetTypeOption,
+	_AssetTypeLowerName[92:98]:   AssetTypeOption,
+	_AssetTypeName[98:105]:       AssetTypeUnknown,
+	_AssetTypeLowerName[98:105]:  AssetTypeUnknown,
+	_AssetTypeName[105:120]:      AssetTypeCashEquivalent,
+	_AssetTypeLowerName[105:120]: AssetTypeCashEquivalent,
+	_AssetTypeName[120:132]:      AssetTypeFixedIncome,
+	_AssetTypeLowerName[120:132]: AssetTypeFixedIncome,
+	_AssetTypeName[132:140]:      AssetTypeCurrency,
+	_AssetTypeLowerName[132:140]: AssetTypeCurrency,
+	_AssetTypeName[140:161]:      AssetTypeCollectiveInvestment,
+	_AssetTypeLowerName[140:161]: AssetTypeCollectiveInvestment,
+	_AssetTypeName[161:168]:      AssetTypeProduct,
+	_AssetTypeLowerName[161:168]: AssetTypeProduct,
 }
 
 var _AssetTypeNames = []string{
@@ -89,6 +104,11 @@ var _AssetTypeNames = []string{
 	_AssetTypeName[81:92],
 	_AssetTypeName[92:98],
 	_AssetTypeName[98:105],
+	_AssetTypeName[105:120],
+	_AssetTypeName[120:132],
+	_AssetTypeName[132:140],
+	_AssetTypeName[140:161],
+	_AssetTypeName[161:168],
 }
 
 // AssetTypeString retrieves an enum value from the enum constants string name.
@@ -3,18 +3,27 @@ package td
 import (
 	"context"
 	"errors"
+	"log/slog"
+	"sync"
 	"time"
 
 	"golang.org/x/oauth2"
 )
 
-var ErrMissingRefresh = errors.New(`refresh token is currently required to authenticate.
-Spam schwab emails and tell them this is stupid and they should follow automated authentication`)
+var (
+	ErrMissingRefresh   = errors.New("refresh token is required to authenticate, get one with AuthorizeCode")
+	ErrNotAuthenticated = errors.New("client hasn't authenticated yet")
+)
 
 // Force authentication to happen. The returned token is a copy of the internal one that will be
 // used for future requests. After calling this function there will be automatic refreshes until
-// eventually the refresh token expires. By this point you will need to follow the flow to get a new
-// one, which is unfortunately a manual process
+// eventually the refresh token expires. By this point you will need to get a new one with AuthorizeCode.
+// The client warns before that happens, see WithRefreshExpiryWarning and RefreshTokenRemaining.
+//
+// With a TokenStore, the stored token is preferred over refreshToken, since it's the newest one
+// the client has seen: Schwab can rotate refresh tokens, and AuthorizeCode saves new ones. That
+// also skips fetching a new access token while the stored one is still valid. refreshToken is only
+// used when there's nothing stored or the stored token fails to refresh. Every new token is saved to the store
 func (c *HTTPClient) Authenticate(ctx context.Context, refreshToken string) (oauth2.Token, error) {
 	conf := c.oauthConf
 
@@ -25,30 +34,186 @@ func (c *HTTPClient) Authenticate(ctx context.Context, refreshToken string) (oau
 		"redirectURL", conf.RedirectURL,
 	)
 
+	if c.store != nil {
+		stored, err := c.store.Load(ctx)
+		switch {
+		case errors.Is(err, ErrNoToken):
+		case err != nil:
+			l.WarnContext(ctx, "failed loading stored token, falling back to the refresh token given", "err", err)
+		case stored.RefreshToken != "":
+			if stored.RefreshToken == refreshToken {
+				stored = c.withGivenIssued(stored)
+			}
+
+			t, err := c.useToken(ctx, l, stored)
+			if err == nil || refreshToken == "" || stored.RefreshToken == refreshToken {
+				return t, err
+			}
+
+			l.WarnContext(ctx, "failed refreshing stored token, falling back to the refresh token given", "err", err)
+		}
+	}
+
 	if refreshToken == "" {
 		l.ErrorContext(ctx, "missing refresh token as argument")
 		return oauth2.Token{}, ErrMissingRefresh
 	}
 
-	tkn := &oauth2.Token{RefreshToken: refreshToken}
-	c.http = c.oauthConf.Client(ctx, tkn)
-	t, err := c.oauthConf.TokenSource(ctx, tkn).Token()
+	return c.useToken(ctx, l, c.withGivenIssued(&oauth2.Token{RefreshToken: refreshToken}))
+}
+
+// withGivenIssued applies WithRefreshTokenIssued to tkn, unless it already knows when it was issued
+func (c *HTTPClient) withGivenIssued(tkn *oauth2.Token) *oauth2.Token {
+	if c.refreshIssued.IsZero() || !tokenRefreshIssued(tkn).IsZero() {
+		return tkn
+	}
+
+	return withRefreshIssued(tkn, c.refreshIssued)
+}
+
+// useToken makes tkn the client's token, refreshing it if it's expired
+func (c *HTTPClient) useToken(ctx context.Context, l *slog.Logger, tkn *oauth2.Token) (oauth2.Token, error) {
+	issued := tokenRefreshIssued(tkn)
+	if issued.IsZero() {
+		issued = time.Now()
+	}
+
+	c.trackRefresh(tkn.RefreshToken, issued)
+
+	bg := context.WithoutCancel(ctx)
+	src := &notifyingTokenSource{
+		src:     c.oauthConf.TokenSource(ctx, tkn),
+		onToken: func(t *oauth2.Token) { c.tokenRefreshed(bg, l, t) },
+	}
+
+	// requests can be in flight on other goroutines, e.g. while the socket reconnects
+	c.tokens.mu.Lock()
+	c.http, c.tokens.src = oauth2.NewClient(ctx, src), src
+	c.tokens.mu.Unlock()
+
+	t, err := src.Token()
 	if err != nil {
 		l.ErrorContext(ctx, "failed fetching token", "err", err)
 		return oauth2.Token{}, err
 	}
 
+	l.DebugContext(ctx, "token fetched", "expiry", t.Expiry, "type", t.TokenType)
+	return tokenCopy(t), nil
+}
+
+// Token returns the client's current token, refreshing it first if it's expired
+// or about to. Any refresh is sent to the OnTokenRefresh subscribers
+func (c *HTTPClient) Token() (oauth2.Token, error) {
+	c.tokens.mu.Lock()
+	src := c.tokens.src
+	c.tokens.mu.Unlock()
+
+	if src == nil {
+		return oauth2.Token{}, ErrNotAuthenticated
+	}
+
+	t, err := src.Token()
+	if err != nil {
+		c.logger.Error("failed fetching token", "err", err)
+		return oauth2.Token{}, err
+	}
+
+	return tokenCopy(t), nil
+}
+
+// OnTokenRefresh calls fn with every new token the client gets, whether that's from
+// authenticating or from the automatic refresh of an expired access token. fn is
+// called while the refresh is in progress, so it must not block or call Token.
+// Call the returned function to stop getting them
+func (c *HTTPClient) OnTokenRefresh(fn func(oauth2.Token)) (unsubscribe func()) {
+	c.tokens.mu.Lock()
+	defer c.tokens.mu.Unlock()
+
+	if c.tokens.subs == nil {
+		c.tokens.subs = map[int]func(oauth2.Token){}
+	}
+
+	id := c.tokens.next
+	c.tokens.next++
+	c.tokens.subs[id] = fn
+
+	return func() {
+		c.tokens.mu.Lock()
+		defer c.tokens.mu.Unlock()
+		delete(c.tokens.subs, id)
+	}
+}
+
+// tokenCopy copies t so callers can't touch the one in use, filling in the expiry
+func tokenCopy(t *oauth2.Token) oauth2.Token {
 	expiration := t.Expiry
 	if expiration.IsZero() && t.ExpiresIn > 0 {
 		expiration = time.Now().Add(time.Second * time.Duration(t.ExpiresIn))
 	}
 
-	l.DebugContext(ctx, "token fetched", "expiry", expiration, "type", t.TokenType)
 	return oauth2.Token{
 		AccessToken:  t.AccessToken,
 		TokenType:    t.TokenType,
 		RefreshToken: t.RefreshToken,
 		Expiry:       expiration,
 		ExpiresIn:    t.ExpiresIn,
-	}, nil
+	}
+}
+
+// tokenRefreshed is called with every new token: it tracks the refresh token,
+// which Schwab could rotate, saves the token if there's a store and tells the subscribers
+func (c *HTTPClient) tokenRefreshed(ctx context.Context, l *slog.Logger, t *oauth2.Token) {
+	issued := c.trackRefresh(t.RefreshToken, time.Now())
+	if c.store != nil {
+		if err := c.store.Save(ctx, withRefreshIssued(t, issued)); err != nil {
+			// the token is still good, failing to persist it shouldn't fail the request
+			l.ErrorContext(ctx, "failed saving refreshed token", "err", err)
+		}
+	}
+
+	c.tokens.mu.Lock()
+	subs := make([]func(oauth2.Token), 0, len(c.tokens.subs))
+	for _, fn := range c.tokens.subs {
+		subs = append(subs, fn)
+	}
+	c.tokens.mu.Unlock()
+
+	for _, fn := range subs {
+		fn(tokenCopy(t))
+	}
+}
+
+// tokenState is the token source in use and who wants to hear about new tokens.
+// mu also guards HTTPClient.http, which is swapped out with the source
+type tokenState struct {
+	mu   sync.Mutex
+	src  oauth2.TokenSource
+	next int
+	subs map[int]func(oauth2.Token)
+}
+
+// notifyingTokenSource hands every new token its source gives out to onToken
+type notifyingTokenSource struct {
+	src     oauth2.TokenSource
+	onToken func(*oauth2.Token)
+
+	mu   sync.Mutex
+	last string // access token last handed to onToken
+}
+
+func (n *notifyingTokenSource) Token() (*oauth2.Token, error) {
+	t, err := n.src.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if t.AccessToken != n.last {
+		n.last = t.AccessToken
+		n.onToken(t)
+	}
+
+	return t, nil
 }
@@ -0,0 +1,140 @@
+// Code generated by "enumer -type ChainStrategy -trimprefix ChainStrategy -json -transform upper"; DO NOT EDIT.
+
+package td
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+const _ChainStrategyName = "UNSPECIFIEDSINGLEANALYTICALCOVEREDVERTICALCALENDARSTRANGLESTRADDLEBUTTERFLYCONDORDIAGONALCOLLARROLL"
+
+var _ChainStrategyIndex = [...]uint8{0, 11, 17, 27, 34, 42, 50, 58, 66, 75, 81, 89, 95, 99}
+
+const _ChainStrategyLowerName = "unspecifiedsingleanalyticalcoveredverticalcalendarstranglestraddlebutterflycondordiagonalcollarroll"
+
+func (i ChainStrategy) String() string {
+	if i >= ChainStrategy(len(_ChainStrategyIndex)-1) {
+		return fmt.Sprintf("ChainStrategy(%d)", i)
+	}
+	return _ChainStrategyName[_ChainStrategyIndex[i]:_ChainStrategyIndex[i+1]]
+}
+
+// An "invalid array index" compiler error signifies that the constant values have changed.
+// Re-run the stringer command to generate them again.
+func _ChainStrategyNoOp() {
+	var x [1]struct{}
+	_ = x[ChainStrategyUnspecified-(0)]
+	_ = x[ChainStrategySingle-(1)]
+	_ = x[ChainStrategyAnalytical-(2)]
+	_ = x[ChainStrategyCovered-(3)]
+	_ = x[ChainStrategyVertical-(4)]
+	_ = x[ChainStrategyCalendar-(5)]
+	_ = x[ChainStrategyStrangle-(6)]
+	_ = x[ChainStrategyStraddle-(7)]
+	_ = x[ChainStrategyButterfly-(8)]
+	_ = x[ChainStrategyCondor-(9)]
+	_ = x[ChainStrategyDiagonal-(10)]
+	_ = x[ChainStrategyCollar-(11)]
+	_ = x[ChainStrategyRoll-(12)]
+}
+
+var _ChainStrategyValues = []ChainStrategy{ChainStrategyUnspecified, ChainStrategySingle, ChainStrategyAnalytical, ChainStrategyCovered, ChainStrategyVertical, ChainStrategyCalendar, ChainStrategyStrangle, ChainStrategyStraddle, ChainStrategyButterfly, ChainStrategyCondor, ChainStrategyDiagonal, ChainStrategyCollar, ChainStrategyRoll}
+
+var _ChainStrategyNameToValueMap = map[string]ChainStrategy{
+	_ChainStrategyName[0:11]:       ChainStrategyUnspecified,
+	_ChainStrategyLowerName[0:11]:  ChainStrategyUnspecified,
+	_ChainStrategyName[11:17]:      ChainStrategySingle,
+	_ChainStrategyLowerName[11:17]: ChainStrategySingle,
+	_ChainStrategyName[17:27]:      ChainStrategyAnalytical,
+	_ChainStrategyLowerName[17:27]: ChainStrategyAnalytical,
+	_ChainStrategyName[27:34]:      ChainStrategyCovered,
+	_ChainStrategyLowerName[27:34]: ChainStrategyCovered,
+	_ChainStrategyName[34:42]:      ChainStrategyVertical,
+	_ChainStrategyLowerName[34:42]: ChainStrategyVertical,
+	_ChainStrategyName[42:50]:      ChainStrategyCalendar,
+	_ChainStrategyLowerName[42:50]: ChainStrategyCalendar,
+	_ChainStrategyName[50:58]:      ChainStrategyStrangle,
+	_ChainStrategyLowerName[50:58]: ChainStrategyStrangle,
+	_ChainStrategyName[58:66]:      ChainStrategyStraddle,
+	_ChainStrategyLowerName[58:66]: ChainStrategyStraddle,
+	_ChainStrategyName[66:75]:      ChainStrategyButterfly,
+	_ChainStrategyLowerName[66:75]: ChainStrategyButterfly,
+	_ChainStrategyName[75:81]:      ChainStrategyCondor,
+	_ChainStrategyLowerName[75:81]: ChainStrategyCondor,
+	_ChainStrategyName[81:89]:      ChainStrategyDiagonal,
+	_ChainStrategyLowerName[81:89]: ChainStrategyDiagonal,
+	_ChainStrategyName[89:95]:      ChainStrategyCollar,
+	_ChainStrategyLowerName[89:95]: ChainStrategyCollar,
+	_ChainStrategyName[95:99]:      ChainStrategyRoll,
+	_ChainStrategyLowerName[95:99]: ChainStrategyRoll,
+}
+
+var _ChainStrategyNames = []string{
+	_ChainStrategyName[0:11],
+	_ChainStrategyName[11:17],
+	_ChainStrategyName[17:27],
+	_ChainStrategyName[27:34],
+	_ChainStrategyName[34:42],
+	_ChainStrategyName[42:50],
+	_ChainStrategyName[50:58],
+	_ChainStrategyName[58:66],
+	_ChainStrategyName[66:75],
+	_ChainStrategyName[75:81],
+	_ChainStrategyName[81:89],
+	_ChainStrategyName[89:95],
+	_ChainStrategyName[95:99],
+}
+
+// ChainStrategyString retrieves an enum value from the enum constants string name.
+// Throws an error if the param is not part of the enum.
+func ChainStrategyString(s string) (ChainStrategy, error) {
+	if val, ok := _ChainStrategyNameToValueMap[s]; ok {
+		return val, nil
+	}
+
+	if val, ok := _ChainStrategyNameToValueMap[strings.ToLower(s)]; ok {
+		return val, nil
+	}
+	return 0, fmt.Errorf("%s does not belong to ChainStrategy values", s)
+}
+
+// ChainStrategyValues returns all values of the enum
+func ChainStrategyValues() []ChainStrategy {
+	return _ChainStrategyValues
+}
+
+// ChainStrategyStrings returns a slice of all String values of the enum
+func ChainStrategyStrings() []string {
+	strs := make([]string, len(_ChainStrategyNames))
+	copy(strs, _ChainStrategyNames)
+	return strs
+}
+
+// IsAChainStrategy returns "true" if the value is listed in the enum definition. "false" otherwise
+func (i ChainStrategy) IsAChainStrategy() bool {
+	for _, v := range _ChainStrategyValues {
+		if i == v {
+			return true
+		}
+	}
+	return false
+}
+
+// MarshalJSON implements the json.Marshaler interface for ChainStrategy
+func (i ChainStrategy) MarshalJSON() ([]byte, error) {
+	return json.Marshal(i.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for ChainStrategy
+func (i *ChainStrategy) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("ChainStrategy should be a string, got %s", data)
+	}
+
+	var err error
+	*i, err = ChainStrategyString(s)
+	return err
+}
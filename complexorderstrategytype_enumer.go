@@ -0,0 +1,176 @@
+// Code generated by "enumer -type ComplexOrderStrategyType -trimprefix ComplexOrderStrategyType -json -transform snake-upper"; DO NOT EDIT.
+
+package td
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+const _ComplexOrderStrategyTypeName = "UNSPECIFIEDNONECOVEREDVERTICALBACK_RATIOCALENDARDIAGONALSTRADDLESTRANGLECOLLAR_SYNTHETICBUTTERFLYCONDORIRON_CONDORVERTICAL_ROLLCOLLAR_WITH_STOCKDOUBLE_DIAGONALUNBALANCED_BUTTERFLYUNBALANCED_CONDORUNBALANCED_IRON_CONDORUNBALANCED_VERTICAL_ROLLMUTUAL_FUND_SWAPCUSTOM"
+
+var _ComplexOrderStrategyTypeIndex = [...]uint16{0, 11, 15, 22, 30, 40, 48, 56, 64, 72, 88, 97, 103, 114, 127, 144, 159, 179, 196, 218, 242, 258, 264}
+
+const _ComplexOrderStrategyTypeLowerName = "unspecifiednonecoveredverticalback_ratiocalendardiagonalstraddlestranglecollar_syntheticbutterflycondoriron_condorvertical_rollcollar_with_stockdouble_diagonalunbalanced_butterflyunbalanced_condorunbalanced_iron_condorunbalanced_vertical_rollmutual_fund_swapcustom"
+
+func (i ComplexOrderStrategyType) String() string {
+	if i >= ComplexOrderStrategyType(len(_ComplexOrderStrategyTypeIndex)-1) {
+		return fmt.Sprintf("ComplexOrderStrategyType(%d)", i)
+	}
+	return _ComplexOrderStrategyTypeName[_ComplexOrderStrategyTypeIndex[i]:_ComplexOrderStrategyTypeIndex[i+1]]
+}
+
+// An "invalid array index" compiler error signifies that the constant values have changed.
+// Re-run the stringer command to generate them again.
+func _ComplexOrderStrategyTypeNoOp() {
+	var x [1]struct{}
+	_ = x[ComplexOrderStrategyTypeUnspecified-(0)]
+	_ = x[ComplexOrderStrategyTypeNone-(1)]
+	_ = x[ComplexOrderStrategyTypeCovered-(2)]
+	_ = x[ComplexOrderStrategyTypeVertical-(3)]
+	_ = x[ComplexOrderStrategyTypeBackRatio-(4)]
+	_ = x[ComplexOrderStrategyTypeCalendar-(5)]
+	_ = x[ComplexOrderStrategyTypeDiagonal-(6)]
+	_ = x[ComplexOrderStrategyTypeStraddle-(7)]
+	_ = x[ComplexOrderStrategyTypeStrangle-(8)]
+	_ = x[ComplexOrderStrategyTypeCollarSynthetic-(9)]
+	_ = x[ComplexOrderStrategyTypeButterfly-(10)]
+	_ = x[ComplexOrderStrategyTypeCondor-(11)]
+	_ = x[ComplexOrderStrategyTypeIronCondor-(12)]
+	_ = x[ComplexOrderStrategyTypeVerticalRoll-(13)]
+	_ = x[ComplexOrderStrategyTypeCollarWithStock-(14)]
+	_ = x[ComplexOrderStrategyTypeDoubleDiagonal-(15)]
+	_ = x[ComplexOrderStrategyTypeUnbalancedButterfly-(16)]
+	_ = x[ComplexOrderStrategyTypeUnbalancedCondor-(17)]
+	_ = x[ComplexOrderStrategyTypeUnbalancedIronCondor-(18)]
+	_ = x[ComplexOrderStrategyTypeUnbalancedVerticalRoll-(19)]
+	_ = x[ComplexOrderStrategyTypeMutualFundSwap-(20)]
+	_ = x[ComplexOrderStrategyTypeCustom-(21)]
+}
+
+var _ComplexOrderStrategyTypeValues = []ComplexOrderStrategyType{ComplexOrderStrategyTypeUnspecified, ComplexOrderStrategyTypeNone, ComplexOrderStrategyTypeCovered, ComplexOrderStrategyTypeVertical, ComplexOrderStrategyTypeBackRatio, ComplexOrderStrategyTypeCalendar, ComplexOrderStrategyTypeDiagonal, ComplexOrderStrategyTypeStraddle, ComplexOrderStrategyTypeStrangle, ComplexOrderStrategyTypeCollarSynthetic, ComplexOrderStrategyTypeButterfly, ComplexOrderStrategyTypeCondor, ComplexOrderStrategyTypeIronCondor, ComplexOrderStrategyTypeVerticalRoll, ComplexOrderStrategyTypeCollarWithStock, ComplexOrderStrategyTypeDoubleDiagonal, ComplexOrderStrategyTypeUnbalancedButterfly, ComplexOrderStrategyTypeUnbalancedCondor, ComplexOrderStrategyTypeUnbalancedIronCondor, ComplexOrderStrategyTypeUnbalancedVerticalRoll, ComplexOrderStrategyTypeMutualFundSwap, ComplexOrderStrategyTypeCustom}
+
+var _ComplexOrderStrategyTypeNameToValueMap = map[string]ComplexOrderStrategyType{
+	_ComplexOrderStrategyTypeName[0:11]:         ComplexOrderStrategyTypeUnspecified,
+	_ComplexOrderStrategyTypeLowerName[0:11]:    ComplexOrderStrategyTypeUnspecified,
+	_ComplexOrderStrategyTypeName[11:15]:        ComplexOrderStrategyTypeNone,
+	_ComplexOrderStrategyTypeLowerName[11:15]:   ComplexOrderStrategyTypeNone,
+	_ComplexOrderStrategyTypeName[15:22]:        ComplexOrderStrategyTypeCovered,
+	_ComplexOrderStrategyTypeLowerName[15:22]:   ComplexOrderStrategyTypeCovered,
+	_ComplexOrderStrategyTypeName[22:30]:        ComplexOrderStrategyTypeVertical,
+	_ComplexOrderStrategyTypeLowerName[22:30]:   ComplexOrderStrategyTypeVertical,
+	_ComplexOrderStrategyTypeName[30:40]:        ComplexOrderStrategyTypeBackRatio,
+	_ComplexOrderStrategyTypeLowerName[30:40]:   ComplexOrderStrategyTypeBackRatio,
+	_ComplexOrderStrategyTypeName[40:48]:        ComplexOrderStrategyTypeCalendar,
+	_ComplexOrderStrategyTypeLowerName[40:48]:   ComplexOrderStrategyTypeCalendar,
+	_ComplexOrderStrategyTypeName[48:56]:        ComplexOrderStrategyTypeDiagonal,
+	_ComplexOrderStrategyTypeLowerName[48:56]:   ComplexOrderStrategyTypeDiagonal,
+	_ComplexOrderStrategyTypeName[56:64]:        ComplexOrderStrategyTypeStraddle,
+	_ComplexOrderStrategyTypeLowerName[56:64]:   ComplexOrderStrategyTypeStraddle,
+	_ComplexOrderStrategyTypeName[64:72]:        ComplexOrderStrategyTypeStrangle,
+	_ComplexOrderStrategyTypeLowerName[64:72]:   ComplexOrderStrategyTypeStrangle,
+	_ComplexOrderStrategyTypeName[72:88]:        ComplexOrderStrategyTypeCollarSynthetic,
+	_ComplexOrderStrategyTypeLowerName[72:88]:   ComplexOrderStrategyTypeCollarSynthetic,
+	_ComplexOrderStrategyTypeName[88:97]:        ComplexOrderStrategyTypeButterfly,
+	_ComplexOrderStrategyTypeLowerName[88:97]:   ComplexOrderStrategyTypeButterfly,
+	_ComplexOrderStrategyTypeName[97:103]:       ComplexOrderStrategyTypeCondor,
+	_ComplexOrderStrategyTypeLowerName[97:103]:  ComplexOrderStrategyTypeCondor,
+	_ComplexOrderStrategyTypeName[103:114]:      ComplexOrderStrategyTypeIronCondor,
+	_ComplexOrderStrategyTypeLowerName[103:114]: ComplexOrderStrategyTypeIronCondor,
+	_ComplexOrderStrategyTypeName[114:127]:      ComplexOrderStrategyTypeVerticalRoll,
+	_ComplexOrderStrategyTypeLowerName[114:127]: ComplexOrderStrategyTypeVerticalRoll,
+	_ComplexOrderStrategyTypeName[127:144]:      ComplexOrderStrategyTypeCollarWithStock,
+	_ComplexOrderStrategyTypeLowerName[127:144]: ComplexOrderStrategyTypeCollarWithStock,
+	_ComplexOrderStrategyTypeName[144:159]:      ComplexOrderStrategyTypeDoubleDiagonal,
+	_ComplexOrderStrategyTypeLowerName[144:159]: ComplexOrderStrategyTypeDoubleDiagonal,
+	_ComplexOrderStrategyTypeName[159:179]:      ComplexOrderStrategyTypeUnbalancedButterfly,
+	_ComplexOrderStrategyTypeLowerName[159:179]: ComplexOrderStrategyTypeUnbalancedButterfly,
+	_ComplexOrderStrategyTypeName[179:196]:      ComplexOrderStrategyTypeUnbalancedCondor,
+	_ComplexOrderStrategyTypeLowerName[179:196]: ComplexOrderStrategyTypeUnbalancedCondor,
+	_ComplexOrderStrategyTypeName[196:218]:      ComplexOrderStrategyTypeUnbalancedIronCondor,
+	_ComplexOrderStrategyTypeLowerName[196:218]: ComplexOrderStrategyTypeUnbalancedIronCondor,
+	_ComplexOrderStrategyTypeName[218:242]:      ComplexOrderStrategyTypeUnbalancedVerticalRoll,
+	_ComplexOrderStrategyTypeLowerName[218:242]: ComplexOrderStrategyTypeUnbalancedVerticalRoll,
+	_ComplexOrderStrategyTypeName[242:258]:      ComplexOrderStrategyTypeMutualFundSwap,
+	_ComplexOrderStrategyTypeLowerName[242:258]: ComplexOrderStrategyTypeMutualFundSwap,
+	_ComplexOrderStrategyTypeName[258:264]:      ComplexOrderStrategyTypeCustom,
+	_ComplexOrderStrategyTypeLowerName[258:264]: ComplexOrderStrategyTypeCustom,
+}
+
+var _ComplexOrderStrategyTypeNames = []string{
+	_ComplexOrderStrategyTypeName[0:11],
+	_ComplexOrderStrategyTypeName[11:15],
+	_ComplexOrderStrategyTypeName[15:22],
+	_ComplexOrderStrategyTypeName[22:30],
+	_ComplexOrderStrategyTypeName[30:40],
+	_ComplexOrderStrategyTypeName[40:48],
+	_ComplexOrderStrategyTypeName[48:56],
+	_ComplexOrderStrategyTypeName[56:64],
+	_ComplexOrderStrategyTypeName[64:72],
+	_ComplexOrderStrategyTypeName[72:88],
+	_ComplexOrderStrategyTypeName[88:97],
+	_ComplexOrderStrategyTypeName[97:103],
+	_ComplexOrderStrategyTypeName[103:114],
+	_ComplexOrderStrategyTypeName[114:127],
+	_ComplexOrderStrategyTypeName[127:144],
+	_ComplexOrderStrategyTypeName[144:159],
+	_ComplexOrderStrategyTypeName[159:179],
+	_ComplexOrderStrategyTypeName[179:196],
+	_ComplexOrderStrategyTypeName[196:218],
+	_ComplexOrderStrategyTypeName[218:242],
+	_ComplexOrderStrategyTypeName[242:258],
+	_ComplexOrderStrategyTypeName[258:264],
+}
+
+// ComplexOrderStrategyTypeString retrieves an enum value from the enum constants string name.
+// Throws an error if the param is not part of the enum.
+func ComplexOrderStrategyTypeString(s string) (ComplexOrderStrategyType, error) {
+	if val, ok := _ComplexOrderStrategyTypeNameToValueMap[s]; ok {
+		return val, nil
+	}
+
+	if val, ok := _ComplexOrderStrategyTypeNameToValueMap[strings.ToLower(s)]; ok {
+		return val, nil
+	}
+	return 0, fmt.Errorf("%s does not belong to ComplexOrderStrategyType values", s)
+}
+
+// ComplexOrderStrategyTypeValues returns all values of the enum
+func ComplexOrderStrategyTypeValues() []ComplexOrderStrategyType {
+	return _ComplexOrderStrategyTypeValues
+}
+
+// ComplexOrderStrategyTypeStrings returns a slice of all String values of the enum
+func ComplexOrderStrategyTypeStrings() []string {
+	strs := make([]string, len(_ComplexOrderStrategyTypeNames))
+	copy(strs, _ComplexOrderStrategyTypeNames)
+	return strs
+}
+
+// IsAComplexOrderStrategyType returns "true" if the value is listed in the enum definition. "false" otherwise
+func (i ComplexOrderStrategyType) IsAComplexOrderStrategyType() bool {
+	for _, v := range _ComplexOrderStrategyTypeValues {
+		if i == v {
+			return true
+		}
+	}
+	return false
+}
+
+// MarshalJSON implements the json.Marshaler interface for ComplexOrderStrategyType
+func (i ComplexOrderStrategyType) MarshalJSON() ([]byte, error) {
+	return json.Marshal(i.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for ComplexOrderStrategyType
+func (i *ComplexOrderStrategyType) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("ComplexOrderStrategyType should be a string, got %s", data)
+	}
+
+	var err error
+	*i, err = ComplexOrderStrategyTypeString(s)
+	return err
+}
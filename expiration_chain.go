@@ -0,0 +1,139 @@
+package td
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"net/url"
+	"time"
+)
+
+//go:generate enumer -type ExpirationType -trimprefix ExpirationType
+type ExpirationType byte
+
+const (
+	ExpirationTypeUnspecified ExpirationType = iota
+	ExpirationTypeStandard                   // Monthly, third friday of the month
+	ExpirationTypeWeekly
+	ExpirationTypeMonthly // End of month
+	ExpirationTypeQuarterly
+)
+
+func (e *ExpirationType) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+
+	switch s {
+	case "S":
+		*e = ExpirationTypeStandard
+	case "W":
+		*e = ExpirationTypeWeekly
+	case "M":
+		*e = ExpirationTypeMonthly
+	case "Q":
+		*e = ExpirationTypeQuarterly
+	default:
+		*e = ExpirationTypeUnspecified
+	}
+
+	return nil
+}
+
+//go:generate enumer -type SettlementType -trimprefix SettlementType
+type SettlementType byte
+
+const (
+	SettlementTypeUnspecified SettlementType = iota
+	SettlementTypeAM                         // Settles on the opening price, e.g. SPX monthlies
+	SettlementTypePM                         // Settles on the closing price
+)
+
+func (s *SettlementType) UnmarshalJSON(b []byte) error {
+	var x string
+	if err := json.Unmarshal(b, &x); err != nil {
+		return err
+	}
+
+	switch x {
+	case "A":
+		*s = SettlementTypeAM
+	case "P":
+		*s = SettlementTypePM
+	default:
+		*s = SettlementTypeUnspecified
+	}
+
+	return nil
+}
+
+type ExpirationChain struct {
+	Status      string       `json:"status"`
+	Expirations []Expiration `json:"expirationList"`
+}
+
+type Expiration struct {
+	Date             time.Time      `json:"-"` // Midnight UTC on the expiration date, same as OptionID.Expiration expects
+	DaysToExpiration int            `json:"daysToExpiration"`
+	Type             ExpirationType `json:"expirationType"`
+	Settlement       SettlementType `json:"settlementType"`
+	OptionRoots      string         `json:"optionRoots"`
+	Standard         bool           `json:"standard"` // False for adjusted contracts, e.g. after a split
+}
+
+func (e *Expiration) UnmarshalJSON(b []byte) error {
+	type expiration Expiration
+	x := struct {
+		*expiration
+		Date string `json:"expirationDate"`
+	}{expiration: (*expiration)(e)}
+
+	if err := json.Unmarshal(b, &x); err != nil {
+		return err
+	}
+
+	var err error
+	if e.Date, err = time.Parse(chainDateFmt, x.Date); err != nil {
+		return fmt.Errorf("invalid expiration date %s: %w", x.Date, err)
+	}
+
+	return nil
+}
+
+// StandardExpirations yields the date of every standard (non adjusted)
+// expiration, ready to use as OptionID.Expiration. Underlyings with more than
+// one root, like SPX and SPXW, list a date once per root; it's only yielded once
+func (e *ExpirationChain) StandardExpirations() iter.Seq[time.Time] {
+	return func(yield func(time.Time) bool) {
+		var last time.Time
+		for _, v := range e.Expirations {
+			// the list is sorted by date, so repeats are next to each other
+			if !v.Standard || v.Date.Equal(last) {
+				continue
+			}
+
+			last = v.Date
+
+			if !yield(v.Date) {
+				return
+			}
+		}
+	}
+}
+
+// ExpirationChain lists every expiration that has options listed for symbol
+func (c *HTTPClient) ExpirationChain(ctx context.Context, symbol string) (*ExpirationChain, error) {
+	if symbol == "" {
+		c.logger.ErrorContext(ctx, "missing symbol for expiration chain")
+		return nil, ErrMissingSymbol
+	}
+
+	chain := new(ExpirationChain)
+	if err := c.marketData(ctx, "/expirationchain?symbol="+url.QueryEscape(symbol), chain); err != nil {
+		return nil, err
+	}
+
+	return chain, nil
+}
@@ -0,0 +1,40 @@
+package td
+
+import (
+	"context"
+	"reflect"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestExpirationChain(t *testing.T) {
+	c := mockHTTP(t, "/expirationchain", "symbol=AAPL", `{"status":"SUCCESS","expirationList":[
+{"expirationDate":"2025-01-10","daysToExpiration":3,"expirationType":"W","settlementType":"P","optionRoots":"AAPL","standard":true},
+{"expirationDate":"2025-01-17","daysToExpiration":10,"expirationType":"S","settlementType":"P","optionRoots":"AAPL","standard":true},
+{"expirationDate":"2025-01-17","daysToExpiration":10,"expirationType":"W","settlementType":"P","optionRoots":"AAPLW","standard":true},
+{"expirationDate":"2025-01-17","daysToExpiration":10,"expirationType":"S","settlementType":"P","optionRoots":"AAPL1","standard":false}]}`)
+
+	chain, err := c.ExpirationChain(context.Background(), "AAPL")
+	if err != nil {
+		t.Fatalf("should not fail, got %s", err)
+	}
+
+	want := Expiration{
+		Date:             time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC),
+		DaysToExpiration: 3,
+		Type:             ExpirationTypeWeekly,
+		Settlement:       SettlementTypePM,
+		OptionRoots:      "AAPL",
+		Standard:         true,
+	}
+
+	if len(chain.Expirations) != 4 || !reflect.DeepEqual(chain.Expirations[0], want) {
+		t.Fatalf("want %+v first of 4, got %+v", want, chain.Expirations)
+	}
+
+	got := slices.Collect(chain.StandardExpirations())
+	if len(got) != 2 || !got[1].Equal(time.Date(2025, 1, 17, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("adjusted expirations and repeated dates should be skipped, got %v", got)
+	}
+}
@@ -62,13 +62,17 @@ func (s *WS) wait(ctx context.Context, f *socketReq) (v *apiResp, err error) {
 	ctx, cancel := context.WithDeadline(ctx, f.deadline)
 	defer cancel()
 
+	s.mu.RLock()
+	connCtx := s.connCtx
+	s.mu.RUnlock()
+
 	select {
-	case <-s.connCtx.Done():
-		err = s.connCtx.Err()
+	case <-connCtx.Done():
+		err = connCtx.Err()
 		s.logger.ErrorContext(ctx, "connection context canceled before response could be received", "err", err)
 	case <-ctx.Done():
 		err = ctx.Err()
-		s.logger.ErrorContext(s.connCtx, "request context canceled before response could be received", "err", err)
+		s.logger.ErrorContext(connCtx, "request context canceled before response could be received", "err", err)
 	case v = <-f.c:
 		if v != nil {
 			return v, nil
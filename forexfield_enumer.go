@@ -0,0 +1,190 @@
+// Code generated by "enumer -type ForexField -trimprefix ForexField"; DO NOT EDIT.
+
+package td
+
+import (
+	"fmt"
+	"strings"
+)
+
+const _ForexFieldName = "SymbolBidPriceAskPriceLastPriceBidSizeAskSizeTotalVolumeLastSizeQuoteTimeTradeTimeHighPriceLowPriceClosePriceExchangeDescriptionOpenPriceNetChangePercentChangeExchangeNameDigitsSecurityStatusTickTickAmountProductTradingHoursIsTradableMarketMaker52WeekHigh52WeekLowMark"
+
+var _ForexFieldIndex = [...]uint16{0, 6, 14, 22, 31, 38, 45, 56, 64, 73, 82, 91, 99, 109, 117, 128, 137, 146, 159, 171, 177, 191, 195, 205, 212, 224, 234, 245, 255, 264, 268}
+
+const _ForexFieldLowerName = "symbolbidpriceaskpricelastpricebidsizeasksizetotalvolumelastsizequotetimetradetimehighpricelowpriceclosepriceexchangedescriptionopenpricenetchangepercentchangeexchangenamedigitssecuritystatusticktickamountproducttradinghoursistradablemarketmaker52weekhigh52weeklowmark"
+
+func (i ForexField) String() string {
+	if i >= ForexField(len(_ForexFieldIndex)-1) {
+		return fmt.Sprintf("ForexField(%d)", i)
+	}
+	return _ForexFieldName[_ForexFieldIndex[i]:_ForexFieldIndex[i+1]]
+}
+
+// An "invalid array index" compiler error signifies that the constant values have changed.
+// Re-run the stringer command to generate them again.
+func _ForexFieldNoOp() {
+	var x [1]struct{}
+	_ = x[ForexFieldSymbol-(0)]
+	_ = x[ForexFieldBidPrice-(1)]
+	_ = x[ForexFieldAskPrice-(2)]
+	_ = x[ForexFieldLastPrice-(3)]
+	_ = x[ForexFieldBidSize-(4)]
+	_ = x[ForexFieldAskSize-(5)]
+	_ = x[ForexFieldTotalVolume-(6)]
+	_ = x[ForexFieldLastSize-(7)]
+	_ = x[ForexFieldQuoteTime-(8)]
+	_ = x[ForexFieldTradeTime-(9)]
+	_ = x[ForexFieldHighPrice-(10)]
+	_ = x[ForexFieldLowPrice-(11)]
+	_ = x[ForexFieldClosePrice-(12)]
+	_ = x[ForexFieldExchange-(13)]
+	_ = x[ForexFieldDescription-(14)]
+	_ = x[ForexFieldOpenPrice-(15)]
+	_ = x[ForexFieldNetChange-(16)]
+	_ = x[ForexFieldPercentChange-(17)]
+	_ = x[ForexFieldExchangeName-(18)]
+	_ = x[ForexFieldDigits-(19)]
+	_ = x[ForexFieldSecurityStatus-(20)]
+	_ = x[ForexFieldTick-(21)]
+	_ = x[ForexFieldTickAmount-(22)]
+	_ = x[ForexFieldProduct-(23)]
+	_ = x[ForexFieldTradingHours-(24)]
+	_ = x[ForexFieldIsTradable-(25)]
+	_ = x[ForexFieldMarketMaker-(26)]
+	_ = x[ForexField52WeekHigh-(27)]
+	_ = x[ForexField52WeekLow-(28)]
+	_ = x[ForexFieldMark-(29)]
+}
+
+var _ForexFieldValues = []ForexField{ForexFieldSymbol, ForexFieldBidPrice, ForexFieldAskPrice, ForexFieldLastPrice, ForexFieldBidSize, ForexFieldAskSize, ForexFieldTotalVolume, ForexFieldLastSize, ForexFieldQuoteTime, ForexFieldTradeTime, ForexFieldHighPrice, ForexFieldLowPrice, ForexFieldClosePrice, ForexFieldExchange, ForexFieldDescription, ForexFieldOpenPrice, ForexFieldNetChange, ForexFieldPercentChange, ForexFieldExchangeName, ForexFieldDigits, ForexFieldSecurityStatus, ForexFieldTick, ForexFieldTickAmount, ForexFieldProduct, ForexFieldTradingHours, ForexFieldIsTradable, ForexFieldMarketMaker, ForexField52WeekHigh, ForexField52WeekLow, ForexFieldMark}
+
+var _ForexFieldNameToValueMap = map[string]ForexField{
+	_ForexFieldName[0:6]:          ForexFieldSymbol,
+	_ForexFieldLowerName[0:6]:     ForexFieldSymbol,
+	_ForexFieldName[6:14]:         ForexFieldBidPrice,
+	_ForexFieldLowerName[6:14]:    ForexFieldBidPrice,
+	_ForexFieldName[14:22]:        ForexFieldAskPrice,
+	_ForexFieldLowerName[14:22]:   ForexFieldAskPrice,
+	_ForexFieldName[22:31]:        ForexFieldLastPrice,
+	_ForexFieldLowerName[22:31]:   ForexFieldLastPrice,
+	_ForexFieldName[31:38]:        ForexFieldBidSize,
+	_ForexFieldLowerName[31:38]:   ForexFieldBidSize,
+	_ForexFieldName[38:45]:        ForexFieldAskSize,
+	_ForexFieldLowerName[38:45]:   ForexFieldAskSize,
+	_ForexFieldName[45:56]:        ForexFieldTotalVolume,
+	_ForexFieldLowerName[45:56]:   ForexFieldTotalVolume,
+	_ForexFieldName[56:64]:        ForexFieldLastSize,
+	_ForexFieldLowerName[56:64]:   ForexFieldLastSize,
+	_ForexFieldName[64:73]:        ForexFieldQuoteTime,
+	_ForexFieldLowerName[64:73]:   ForexFieldQuoteTime,
+	_ForexFieldName[73:82]:        ForexFieldTradeTime,
+	_ForexFieldLowerName[73:82]:   ForexFieldTradeTime,
+	_ForexFieldName[82:91]:        ForexFieldHighPrice,
+	_ForexFieldLowerName[82:91]:   ForexFieldHighPrice,
+	_ForexFieldName[91:99]:        ForexFieldLowPrice,
+	_ForexFieldLowerName[91:99]:   ForexFieldLowPrice,
+	_ForexFieldName[99:109]:       ForexFieldClosePrice,
+	_ForexFieldLowerName[99:109]:  ForexFieldClosePrice,
+	_ForexFieldName[109:117]:      ForexFieldExchange,
+	_ForexFieldLowerName[109:117]: ForexFieldExchange,
+	_ForexFieldName[117:128]:      ForexFieldDescription,
+	_ForexFieldLowerName[117:128]: ForexFieldDescription,
+	_ForexFieldName[128:137]:      ForexFieldOpenPrice,
+	_ForexFieldLowerName[128:137]: ForexFieldOpenPrice,
+	_ForexFieldName[137:146]:      ForexFieldNetChange,
+	_ForexFieldLowerName[137:146]: ForexFieldNetChange,
+	_ForexFieldName[146:159]:      ForexFieldPercentChange,
+	_ForexFieldLowerName[146:159]: ForexFieldPercentChange,
+	_ForexFieldName[159:171]:      ForexFieldExchangeName,
+	_ForexFieldLowerName[159:171]: ForexFieldExchangeName,
+	_ForexFieldName[171:177]:      ForexFieldDigits,
+	_ForexFieldLowerName[171:177]: ForexFieldDigits,
+	_ForexFieldName[177:191]:      ForexFieldSecurityStatus,
+	_ForexFieldLowerName[177:191]: ForexFieldSecurityStatus,
+	_ForexFieldName[191:195]:      ForexFieldTick,
+	_ForexFieldLowerName[191:195]: ForexFieldTick,
+	_ForexFieldName[195:205]:      ForexFieldTickAmount,
+	_ForexFieldLowerName[195:205]: ForexFieldTickAmount,
+	_ForexFieldName[205:212]:      ForexFieldProduct,
+	_ForexFieldLowerName[205:212]: ForexFieldProduct,
+	_ForexFieldName[212:224]:      ForexFieldTradingHours,
+	_ForexFieldLowerName[212:224]: ForexFieldTradingHours,
+	_ForexFieldName[224:234]:      ForexFieldIsTradable,
+	_ForexFieldLowerName[224:234]: ForexFieldIsTradable,
+	_ForexFieldName[234:245]:      ForexFieldMarketMaker,
+	_ForexFieldLowerName[234:245]: ForexFieldMarketMaker,
+	_ForexFieldName[245:255]:      ForexField52WeekHigh,
+	_ForexFieldLowerName[245:255]: ForexField52WeekHigh,
+	_ForexFieldName[255:264]:      ForexField52WeekLow,
+	_ForexFieldLowerName[255:264]: ForexField52WeekLow,
+	_ForexFieldName[264:268]:      ForexFieldMark,
+	_ForexFieldLowerName[264:268]: ForexFieldMark,
+}
+
+var _ForexFieldNames = []string{
+	_ForexFieldName[0:6],
+	_ForexFieldName[6:14],
+	_ForexFieldName[14:22],
+	_ForexFieldName[22:31],
+	_ForexFieldName[31:38],
+	_ForexFieldName[38:45],
+	_ForexFieldName[45:56],
+	_ForexFieldName[56:64],
+	_ForexFieldName[64:73],
+	_ForexFieldName[73:82],
+	_ForexFieldName[82:91],
+	_ForexFieldName[91:99],
+	_ForexFieldName[99:109],
+	_ForexFieldName[109:117],
+	_ForexFieldName[117:128],
+	_ForexFieldName[128:137],
+	_ForexFieldName[137:146],
+	_ForexFieldName[146:159],
+	_ForexFieldName[159:171],
+	_ForexFieldName[171:177],
+	_ForexFieldName[177:191],
+	_ForexFieldName[191:195],
+	_ForexFieldName[195:205],
+	_ForexFieldName[205:212],
+	_ForexFieldName[212:224],
+	_ForexFieldName[224:234],
+	_ForexFieldName[234:245],
+	_ForexFieldName[245:255],
+	_ForexFieldName[255:264],
+	_ForexFieldName[264:268],
+}
+
+// ForexFieldString retrieves an enum value from the enum constants string name.
+// Throws an error if the param is not part of the enum.
+func ForexFieldString(s string) (ForexField, error) {
+	if val, ok := _ForexFieldNameToValueMap[s]; ok {
+		return val, nil
+	}
+
+	if val, ok := _ForexFieldNameToValueMap[strings.ToLower(s)]; ok {
+		return val, nil
+	}
+	return 0, fmt.Errorf("%s does not belong to ForexField values", s)
+}
+
+// ForexFieldValues returns all values of the enum
+func ForexFieldValues() []ForexField {
+	return _ForexFieldValues
+}
+
+// ForexFieldStrings returns a slice of all String values of the enum
+func ForexFieldStrings() []string {
+	strs := make([]string, len(_ForexFieldNames))
+	copy(strs, _ForexFieldNames)
+	return strs
+}
+
+// IsAForexField returns "true" if the value is listed in the enum definition. "false" otherwise
+func (i ForexField) IsAForexField() bool {
+	for _, v := range _ForexFieldValues {
+		if i == v {
+			return true
+		}
+	}
+	return false
+}
@@ -10,6 +10,7 @@ import (
 	"log/slog"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"golang.org/x/oauth2"
@@ -17,6 +18,7 @@ import (
 
 const (
 	AuthUrl       = "https://api.schwabapi.com/v1/oauth/token"
+	AuthorizeURL  = "https://api.schwabapi.com/v1/oauth/authorize"
 	ProdURL       = "https://api.schwabapi.com/trader/v1"
 	ProdMarketURL = "https://api.schwabapi.com/marketdata/v1"
 )
@@ -26,9 +28,17 @@ const (
 // the preferred client method due to better latency all around
 type HTTPClient struct {
 	baseURL, token string
+	marketURL      string // Market data lives on a different host than the trader API
+	store          TokenStore
 	oauthConf      oauth2.Config
 	logger         *slog.Logger
 	http           *http.Client
+	tokens         tokenState
+
+	refreshIssued   time.Time // From WithRefreshTokenIssued
+	refreshWarnings []time.Duration
+	onRefreshExpiry func(RefreshExpiry)
+	refresh         refreshTracker
 }
 
 type HTTPClientOpt func(c *HTTPClient)
@@ -37,6 +47,23 @@ type HTTPClientOpt func(c *HTTPClient)
 // to avoid fetching one
 func WithHTTPAccessToken(s string) HTTPClientOpt { return func(c *HTTPClient) { c.token = s } }
 
+// Point market data requests (quotes, price history, chains, etc.) somewhere
+// other than ProdMarketURL, e.g. a local fake server
+func WithMarketDataURL(s string) HTTPClientOpt {
+	return func(c *HTTPClient) { c.marketURL = strings.TrimSuffix(s, "/") }
+}
+
+// Persist tokens between runs. The stored token is used when authenticating
+// and every refreshed token is saved back. See FileTokenStore and EncryptedFileTokenStore
+func WithTokenStore(s TokenStore) HTTPClientOpt { return func(c *HTTPClient) { c.store = s } }
+
+// The callback URL registered with your Schwab app, which AuthorizeCode
+// listens on. Defaults to https://127.0.0.1, which is port 443 and needs root
+// on most systems, so register one with a high port like https://127.0.0.1:8182
+func WithRedirectURL(s string) HTTPClientOpt {
+	return func(c *HTTPClient) { c.oauthConf.RedirectURL = s }
+}
+
 func WithClientLogger(l slog.Handler) HTTPClientOpt {
 	if l == nil {
 		l = slog.DiscardHandler
@@ -45,15 +72,19 @@ func WithClientLogger(l slog.Handler) HTTPClientOpt {
 	return func(c *HTTPClient) { c.logger = slog.New(l) }
 }
 
+// New creates the client and authenticates it with refreshToken, or the stored token with
+// WithTokenStore. With neither, like on the first run, it doesn't authenticate: get a
+// refresh token with AuthorizeCode before making requests
 func New(ctx context.Context, baseURL, authURL, key, secret, refreshToken string, opts ...HTTPClientOpt) (*HTTPClient, error) {
 	c := &HTTPClient{
-		http:    http.DefaultClient,
-		logger:  slog.New(slog.DiscardHandler),
-		baseURL: strings.TrimSuffix(baseURL, "/"),
+		http:      http.DefaultClient,
+		logger:    slog.New(slog.DiscardHandler),
+		baseURL:   strings.TrimSuffix(baseURL, "/"),
+		marketURL: ProdMarketURL,
 		oauthConf: oauth2.Config{
 			ClientID:     key,
 			ClientSecret: secret,
-			Endpoint:     oauth2.Endpoint{TokenURL: authURL},
+			Endpoint:     oauth2.Endpoint{AuthURL: AuthorizeURL, TokenURL: authURL},
 			RedirectURL:  "https://127.0.0.1",
 		},
 	}
@@ -62,7 +93,14 @@ func New(ctx context.Context, baseURL, authURL, key, secret, refreshToken string
 		v(c)
 	}
 
+	if refreshToken == "" && c.store == nil {
+		return c, nil
+	}
+
 	_, err := c.Authenticate(ctx, refreshToken)
+	if refreshToken == "" && errors.Is(err, ErrMissingRefresh) {
+		return c, nil // nothing stored yet either
+	}
 
 	return c, err
 }
@@ -103,7 +141,23 @@ func (h *HTTPErr) Error() string {
 }
 
 func (c *HTTPClient) do(ctx context.Context, method, path string, body, target any) error {
-	path = fmt.Sprintf("%s%s", c.baseURL, path)
+	_, err := c.doHeader(ctx, method, path, body, target)
+	return err
+}
+
+// doHeader is do, but also returns the response headers for endpoints that
+// send data back in them, like the Location of a newly created order
+func (c *HTTPClient) doHeader(ctx context.Context, method, path string, body, target any) (http.Header, error) {
+	return c.send(ctx, method, c.baseURL+path, body, target)
+}
+
+// marketData is do against the market data API. Every market data endpoint is a GET
+func (c *HTTPClient) marketData(ctx context.Context, path string, target any) error {
+	_, err := c.send(ctx, http.MethodGet, c.marketURL+path, nil, target)
+	return err
+}
+
+func (c *HTTPClient) send(ctx context.Context, method, path string, body, target any) (http.Header, error) {
 	l := c.logger.With("path", path, "method", method)
 
 	var toSend io.Reader
@@ -111,7 +165,7 @@ func (c *HTTPClient) do(ctx context.Context, method, path string, body, target a
 		buf, err := json.Marshal(body)
 		if err != nil {
 			l.ErrorContext(ctx, "failed marshal of payload", "err", err, "type", fmt.Sprintf("%T", body))
-			return err
+			return nil, err
 		}
 		toSend = bytes.NewReader(buf)
 	}
@@ -119,17 +173,21 @@ func (c *HTTPClient) do(ctx context.Context, method, path string, body, target a
 	req, err := http.NewRequestWithContext(ctx, method, path, toSend)
 	if err != nil {
 		l.ErrorContext(ctx, "failed creating new HTTP request", "err", err)
-		return err
+		return nil, err
 	}
 
 	if toSend != nil {
 		req.Header.Add("Content-Type", "application/json")
 	}
 
-	resp, err := c.http.Do(req)
+	c.tokens.mu.Lock()
+	hc := c.http
+	c.tokens.mu.Unlock()
+
+	resp, err := hc.Do(req)
 	if err != nil {
 		l.ErrorContext(ctx, "failed making HTTP request", "err", err)
-		return err
+		return nil, err
 	}
 	defer resp.Body.Close()
 
@@ -138,28 +196,28 @@ func (c *HTTPClient) do(ctx context.Context, method, path string, body, target a
 	buf, err := io.ReadAll(resp.Body)
 	if err != nil {
 		l.ErrorContext(ctx, "failed reading response body", "err", err)
-		return err
+		return nil, err
 	}
 
 	if x > 299 || x < 200 {
 		if err = parseForAPIErr(buf); err != nil {
 			l.ErrorContext(ctx, "received API error(s)", "err", err)
-			return err
+			return nil, err
 		}
 
 		l.ErrorContext(ctx, "got a bad HTTP response code", "code", x, "body", string(buf))
-		return fmt.Errorf("HTTP %d: %s", x, buf)
+		return nil, fmt.Errorf("HTTP %d: %s", x, buf)
 	}
 
 	if target == nil {
-		return nil
+		return resp.Header, nil
 	}
 
 	if err = json.Unmarshal(buf, target); err != nil {
 		l.ErrorContext(ctx, "failed unmarshal into expected response format", "err", err, "body", string(buf))
-		return err
+		return nil, err
 	}
 
 	l.DebugContext(ctx, "successful request/response", "code", resp.StatusCode)
-	return nil
+	return resp.Header, nil
 }
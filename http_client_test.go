@@ -0,0 +1,112 @@
+package td
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+func TestMarketDataURL(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/trader/v1/accounts/accountNumbers", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[{"accountNumber":"12345678","hashValue":"ABCDEF"}]`))
+	})
+	mux.HandleFunc("/marketdata/v1/markets/equity", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"equity":{"EQ":{"date":"2025-01-17","marketType":"EQUITY","product":"EQ","isOpen":true}}}`))
+	})
+
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	c := &HTTPClient{baseURL: srv.URL + "/trader/v1", http: srv.Client(), logger: slog.New(slog.DiscardHandler)}
+	WithMarketDataURL(srv.URL + "/marketdata/v1/")(c)
+
+	if _, err := c.GetAccountNumbers(context.Background()); err != nil {
+		t.Errorf("trader requests should go to the base URL, got %s", err)
+	}
+
+	h, err := c.MarketHoursByMarket(context.Background(), MarketEquity, time.Time{})
+	if err != nil {
+		t.Fatalf("market data requests should go to the market data URL, got %s", err)
+	}
+
+	if !h["EQ"].IsOpen {
+		t.Errorf("market hours decoded incorrectly: %+v", h)
+	}
+}
+
+// mockTokenServer hands out a new access token on /token every call and answers /accounts/accountNumbers
+func mockTokenServer(t *testing.T) (*HTTPClient, context.Context, *atomic.Int32) {
+	t.Helper()
+
+	var calls atomic.Int32
+	mux := http.NewServeMux()
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"access_token":"access%d","token_type":"Bearer","expires_in":1800,"refresh_token":"refresh"}`, calls.Add(1))
+	})
+	mux.HandleFunc("/accounts/accountNumbers", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[]`))
+	})
+
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+
+	c := &HTTPClient{
+		baseURL:   srv.URL,
+		http:      srv.Client(),
+		logger:    slog.New(slog.DiscardHandler),
+		oauthConf: oauth2.Config{Endpoint: oauth2.Endpoint{TokenURL: srv.URL + "/token", AuthStyle: oauth2.AuthStyleInParams}},
+	}
+
+	return c, context.WithValue(context.Background(), oauth2.HTTPClient, srv.Client()), &calls
+}
+
+func TestAuthenticateDuringRequests(t *testing.T) {
+	c, ctx, _ := mockTokenServer(t)
+	if _, err := c.Authenticate(ctx, "refresh"); err != nil {
+		t.Fatalf("should not fail, got %s", err)
+	}
+
+	// run with -race: re-authenticating swaps the http client out from under requests
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for range 10 {
+			if _, err := c.Authenticate(ctx, "refresh"); err != nil {
+				t.Errorf("should not fail, got %s", err)
+			}
+		}
+	}()
+
+	for range 10 {
+		if _, err := c.GetAccountNumbers(ctx); err != nil {
+			t.Errorf("should not fail, got %s", err)
+		}
+	}
+
+	wg.Wait()
+}
+
+func TestNewWithoutRefreshToken(t *testing.T) {
+	ctx := context.Background()
+	for _, opts := range [][]HTTPClientOpt{nil, {WithTokenStore(&memTokenStore{})}} {
+		c, err := New(ctx, ProdURL, "http://127.0.0.1:0/token", "key", "secret", "", opts...)
+		if err != nil {
+			t.Fatalf("first run without a refresh token should not fail, got %s", err)
+		}
+
+		if _, err = c.Token(); err != ErrNotAuthenticated {
+			t.Errorf("should not be authenticated yet, got %v", err)
+		}
+	}
+}
@@ -0,0 +1,220 @@
+package td
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// Dates in fundamentals come back like 2024-05-10 00:00:00.0
+const fundamentalTimeFmt = "2006-01-02 15:04:05.0"
+
+var (
+	ErrMissingProjection  = errors.New("missing instrument projection")
+	ErrMissingCUSIP       = errors.New("missing CUSIP")
+	ErrInstrumentNotFound = errors.New("instrument not found")
+)
+
+//go:generate enumer -type InstrumentProjection -trimprefix InstrumentProjection -transform kebab
+type InstrumentProjection byte
+
+const (
+	InstrumentProjectionUnspecified  InstrumentProjection = iota
+	InstrumentProjectionSymbolSearch                      // Exact symbol match
+	InstrumentProjectionSymbolRegex                       // Symbol as a regex, e.g. XYZ.*
+	InstrumentProjectionDescSearch                        // Keyword in the description, e.g. FactSet
+	InstrumentProjectionDescRegex                         // Description as a regex, e.g. XYZ.[A-C]
+	InstrumentProjectionSearch                            // Schwab decides whether it's a symbol or description search
+	InstrumentProjectionFundamental                       // Exact symbol match, with Fundamental populated
+)
+
+// InstrumentInfo is the metadata Schwab keeps on a security, found by SearchInstruments
+// or GetInstrumentByCUSIP
+type InstrumentInfo struct {
+	Cusip       string       `json:"cusip"`
+	Symbol      string       `json:"symbol"`
+	Description string       `json:"description"`
+	Exchange    string       `json:"exchange"`
+	Type        AssetType    `json:"assetType"`
+	Subtype     AssetSubtype `json:"assetSubType"`
+
+	// Only set when using InstrumentProjectionFundamental
+	Fundamental *Fundamental `json:"fundamental"`
+}
+
+type Fundamental struct {
+	Symbol              string    `json:"symbol"`
+	High52              float64   `json:"high52"`
+	Low52               float64   `json:"low52"`
+	DividendAmount      float64   `json:"dividendAmount"`
+	DividendYield       float64   `json:"dividendYield"`
+	DividendDate        time.Time `json:"-"`
+	DividendPayAmount   float64   `json:"dividendPayAmount"`
+	DividendPayDate     time.Time `json:"-"`
+	DividendFreq        int       `json:"dividendFreq"` // Payments per year
+	NextDividendDate    time.Time `json:"-"`
+	NextDividendPayDate time.Time `json:"-"`
+	DeclarationDate     time.Time `json:"-"`
+	CorpactionDate      time.Time `json:"-"`
+	DivGrowthRate3Year  float64   `json:"divGrowthRate3Year"`
+	PERatio             float64   `json:"peRatio"`
+	PEGRatio            float64   `json:"pegRatio"`
+	PBRatio             float64   `json:"pbRatio"`
+	PRRatio             float64   `json:"prRatio"`
+	PCFRatio            float64   `json:"pcfRatio"`
+	GrossMarginTTM      float64   `json:"grossMarginTTM"`
+	GrossMarginMRQ      float64   `json:"grossMarginMRQ"`
+	NetProfitMarginTTM  float64   `json:"netProfitMarginTTM"`
+	NetProfitMarginMRQ  float64   `json:"netProfitMarginMRQ"`
+	OperatingMarginTTM  float64   `json:"operatingMarginTTM"`
+	OperatingMarginMRQ  float64   `json:"operatingMarginMRQ"`
+	ReturnOnEquity      float64   `json:"returnOnEquity"`
+	ReturnOnAssets      float64   `json:"returnOnAssets"`
+	ReturnOnInvestment  float64   `json:"returnOnInvestment"`
+	QuickRatio          float64   `json:"quickRatio"`
+	CurrentRatio        float64   `json:"currentRatio"`
+	InterestCoverage    float64   `json:"interestCoverage"`
+	TotalDebtToCapital  float64   `json:"totalDebtToCapital"`
+	LTDebtToEquity      float64   `json:"ltDebtToEquity"`
+	TotalDebtToEquity   float64   `json:"totalDebtToEquity"`
+	EPS                 float64   `json:"eps"`
+	EPSTTM              float64   `json:"epsTTM"`
+	EPSChangePercentTTM float64   `json:"epsChangePercentTTM"`
+	EPSChangeYear       float64   `json:"epsChangeYear"`
+	EPSChange           float64   `json:"epsChange"`
+	RevChangeYear       float64   `json:"revChangeYear"`
+	RevChangeTTM        float64   `json:"revChangeTTM"`
+	RevChangeIn         float64   `json:"revChangeIn"`
+	SharesOutstanding   float64   `json:"sharesOutstanding"`
+	MarketCapFloat      float64   `json:"marketCapFloat"`
+	MarketCap           float64   `json:"marketCap"`
+	BookValuePerShare   float64   `json:"bookValuePerShare"`
+	ShortIntToFloat     float64   `json:"shortIntToFloat"`
+	ShortIntDayToCover  float64   `json:"shortIntDayToCover"`
+	Beta                float64   `json:"beta"`
+	Vol1DayAvg          float64   `json:"vol1DayAvg"`
+	Vol10DayAvg         float64   `json:"vol10DayAvg"`
+	Vol3MonthAvg        float64   `json:"vol3MonthAvg"`
+	Avg1DayVolume       int64     `json:"avg1DayVolume"`
+	Avg10DaysVolume     int64     `json:"avg10DaysVolume"`
+	Avg3MonthVolume     int64     `json:"avg3MonthVolume"`
+	DTNVolume           int64     `json:"dtnVolume"`
+	FundLeverageFactor  float64   `json:"fundLeverageFactor"`
+	FundStrategy        string    `json:"fundStrategy"`
+}
+
+// fundamentalTime parses the dates in fundamentals; empty strings are the zero time
+type fundamentalTime time.Time
+
+func (f *fundamentalTime) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+
+	if s == "" {
+		*f = fundamentalTime{}
+		return nil
+	}
+
+	t, err := time.Parse(fundamentalTimeFmt, s)
+	if err != nil {
+		if t, err = time.Parse(time.RFC3339, s); err != nil {
+			return err
+		}
+	}
+
+	*f = fundamentalTime(t)
+	return nil
+}
+
+func (f *Fundamental) UnmarshalJSON(b []byte) error {
+	type fundamental Fundamental
+	x := struct {
+		*fundamental
+		DividendDate        fundamentalTime `json:"dividendDate"`
+		DividendPayDate     fundamentalTime `json:"dividendPayDate"`
+		NextDividendDate    fundamentalTime `json:"nextDividendDate"`
+		NextDividendPayDate fundamentalTime `json:"nextDividendPayDate"`
+		DeclarationDate     fundamentalTime `json:"declarationDate"`
+		CorpactionDate      fundamentalTime `json:"corpactionDate"`
+	}{fundamental: (*fundamental)(f)}
+
+	if err := json.Unmarshal(b, &x); err != nil {
+		return err
+	}
+
+	f.DividendDate = time.Time(x.DividendDate)
+	f.DividendPayDate = time.Time(x.DividendPayDate)
+	f.NextDividendDate = time.Time(x.NextDividendDate)
+	f.NextDividendPayDate = time.Time(x.NextDividendPayDate)
+	f.DeclarationDate = time.Time(x.DeclarationDate)
+	f.CorpactionDate = time.Time(x.CorpactionDate)
+	return nil
+}
+
+// SearchInstruments finds instruments matching query. What query means depends on the projection:
+// a symbol, a regex, or a description keyword
+func (c *HTTPClient) SearchInstruments(ctx context.Context, query string, projection InstrumentProjection) ([]InstrumentInfo, error) {
+	switch {
+	case query == "":
+		c.logger.ErrorContext(ctx, "missing query for instrument search")
+		return nil, ErrMissingSymbol
+	case projection == InstrumentProjectionUnspecified || !projection.IsAInstrumentProjection():
+		c.logger.ErrorContext(ctx, "missing projection for instrument search", "projection", int(projection))
+		return nil, ErrMissingProjection
+	}
+
+	q := url.Values{"symbol": {query}, "projection": {projection.String()}}
+
+	var resp struct {
+		Instruments []InstrumentInfo `json:"instruments"`
+	}
+
+	if err := c.marketData(ctx, "/instruments?"+q.Encode(), &resp); err != nil {
+		return nil, err
+	}
+
+	return resp.Instruments, nil
+}
+
+// GetInstrumentByCUSIP looks up a single instrument by its CUSIP
+func (c *HTTPClient) GetInstrumentByCUSIP(ctx context.Context, cusip string) (*InstrumentInfo, error) {
+	if cusip == "" {
+		c.logger.ErrorContext(ctx, "missing cusip for instrument lookup")
+		return nil, ErrMissingCUSIP
+	}
+
+	var raw json.RawMessage
+	if err := c.marketData(ctx, "/instruments/"+url.PathEscape(cusip), &raw); err != nil {
+		return nil, err
+	}
+
+	// documented as a single instrument, but sent wrapped like a search
+	if raw = bytes.TrimSpace(raw); len(raw) > 0 && raw[0] == '{' && bytes.Contains(raw, []byte(`"instruments"`)) {
+		var resp struct {
+			Instruments []InstrumentInfo `json:"instruments"`
+		}
+
+		if err := json.Unmarshal(raw, &resp); err != nil {
+			return nil, err
+		}
+
+		if len(resp.Instruments) == 0 {
+			return nil, fmt.Errorf("%w: %s", ErrInstrumentNotFound, cusip)
+		}
+
+		return &resp.Instruments[0], nil
+	}
+
+	i := new(InstrumentInfo)
+	if err := json.Unmarshal(raw, i); err != nil {
+		return nil, err
+	}
+
+	return i, nil
+}
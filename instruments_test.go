@@ -0,0 +1,54 @@
+package td
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestSearchInstruments(t *testing.T) {
+	c := mockHTTP(t, "/instruments", "projection=fundamental&symbol=AAPL", `{"instruments":[{
+"cusip":"037833100","symbol":"AAPL","description":"Apple Inc","exchange":"NASDAQ","assetType":"EQUITY",
+"fundamental":{"symbol":"AAPL","high52":237.23,"low52":164.075,"dividendAmount":1,"dividendYield":0.44,"dividendDate":"2024-08-12 00:00:00.0","peRatio":34.5,"nextDividendDate":"","dividendFreq":4,"avg10DaysVolume":47000000}}]}`)
+
+	if _, err := c.SearchInstruments(context.Background(), "AAPL", InstrumentProjectionUnspecified); err != ErrMissingProjection {
+		t.Errorf("should require a projection, got %v", err)
+	}
+
+	got, err := c.SearchInstruments(context.Background(), "AAPL", InstrumentProjectionFundamental)
+	if err != nil {
+		t.Fatalf("should not fail, got %s", err)
+	}
+
+	if len(got) != 1 {
+		t.Fatalf("want 1 instrument, got %+v", got)
+	}
+
+	i := got[0]
+	if i.Type != AssetTypeEquity || i.Cusip != "037833100" || i.Fundamental == nil {
+		t.Fatalf("instrument decoded incorrectly: %+v", i)
+	}
+
+	f := i.Fundamental
+	if want := time.Date(2024, 8, 12, 0, 0, 0, 0, time.UTC); !f.DividendDate.Equal(want) {
+		t.Errorf("want dividend date %s, got %s", want, f.DividendDate)
+	}
+
+	if !f.NextDividendDate.IsZero() || f.DividendFreq != 4 || f.PERatio != 34.5 || f.Avg10DaysVolume != 47000000 {
+		t.Errorf("fundamental decoded incorrectly: %+v", f)
+	}
+}
+
+func TestGetInstrumentByCUSIP(t *testing.T) {
+	c := mockHTTP(t, "/instruments/037833100", "", `{"instruments":[{"cusip":"037833100","symbol":"AAPL","description":"Apple Inc","exchange":"NASDAQ","assetType":"EQUITY"}]}`)
+
+	got, err := c.GetInstrumentByCUSIP(context.Background(), "037833100")
+	if err != nil {
+		t.Fatalf("should not fail, got %s", err)
+	}
+
+	want := InstrumentInfo{Cusip: "037833100", Symbol: "AAPL", Description: "Apple Inc", Exchange: "NASDAQ", Type: AssetTypeEquity}
+	if *got != want {
+		t.Errorf("want %+v, got %+v", want, *got)
+	}
+}
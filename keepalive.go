@@ -56,11 +56,25 @@ func (h *notifyMsg) UnmarshalJSON(b []byte) error {
 }
 
 func (s *WS) keepaliveErr(err error) {
-	s.cancel()
+	s.mu.RLock()
+	cancel := s.cancel
+	s.mu.RUnlock()
+
+	cancel()
 	s.errHandler(err)
+
+	if s.backoff == nil || s.closed.Load() || s.ctx.Err() != nil {
+		return
+	}
+
+	if s.reconnecting.CompareAndSwap(false, true) {
+		go s.reconnect(err)
+	}
 }
 
 func (s *WS) ping() {
+	defer s.wg.Done()
+
 	t := time.NewTicker(s.pingEvery)
 	defer t.Stop()
 
@@ -85,7 +99,10 @@ func (s *WS) ping() {
 }
 
 func (s *WS) keepalive() {
+	defer s.wg.Done()
+
 	ch := make(chan []byte, 10)
+	s.wg.Add(2)
 	go s.ping()
 	go s.deserialize(ch)
 	for {
@@ -96,7 +113,12 @@ func (s *WS) keepalive() {
 		}
 
 		s.logger.DebugContext(s.connCtx, "payload received", "raw", string(buf))
-		ch <- buf
+		select {
+		case ch <- buf:
+		case <-s.connCtx.Done():
+			close(ch)
+			return
+		}
 	}
 }
 
@@ -118,7 +140,7 @@ func (s *WS) read(ctx context.Context) ([]byte, error) {
 		ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
 		defer cancel()
 
-		return nil, errors.Join(err, s.Close(ctx))
+		return nil, errors.Join(err, s.logout(ctx))
 	case errors.Is(err, net.ErrClosed):
 		s.logger.ErrorContext(ctx, "websocket closed", "err", err)
 		return nil, err
@@ -142,6 +164,8 @@ func (s *WS) read(ctx context.Context) ([]byte, error) {
 }
 
 func (s *WS) deserialize(ch <-chan []byte) {
+	defer s.wg.Done()
+
 	ctx := s.connCtx
 	d := ctx.Done()
 	for {
@@ -194,6 +218,29 @@ func (s *WS) deserialize(ch <-chan []byte) {
 				}
 
 				go handlerMaker(s.logger, v, s.errHandler, s.futureOptionHandler)
+			case serviceLeveloneForex:
+				if s.forexHandler == nil {
+					s.logger.ErrorContext(s.connCtx, "handler is not defined", "service", v.Service)
+					continue
+				}
+
+				go handlerMaker(s.logger, v, s.errHandler, s.forexHandler)
+			case serviceNyseBook, serviceNasdaqBook, serviceOptionsBook:
+				s.handleBooks(v)
+			case serviceScreenerEquity, serviceScreenerOption:
+				if s.screenerHandler == nil {
+					s.logger.ErrorContext(s.connCtx, "handler is not defined", "service", v.Service)
+					continue
+				}
+
+				go handlerMaker(s.logger, v, s.errHandler, s.screenerHandler)
+			case serviceAcctActivity:
+				if s.acctActivityHandler == nil {
+					s.logger.ErrorContext(s.connCtx, "handler is not defined", "service", v.Service)
+					continue
+				}
+
+				go handlerMaker(s.logger, v, s.errHandler, s.acctActivityHandler)
 			case serviceChartEquity:
 				if s.chartEquityHandler == nil {
 					s.logger.ErrorContext(s.connCtx, "handler is not defined", "service", v.Service)
@@ -0,0 +1,180 @@
+package td
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+var (
+	ErrMissingMarket  = errors.New("missing market")
+	ErrUnknownProduct = errors.New("product not found in market hours")
+	ErrNoSession      = errors.New("no session found")
+)
+
+//go:generate enumer -type Market -trimprefix Market -json -text -transform lower
+type Market byte
+
+const (
+	MarketUnspecified Market = iota
+	MarketEquity
+	MarketOption
+	MarketBond
+	MarketFuture
+	MarketForex
+)
+
+//go:generate enumer -type SessionType -trimprefix SessionType
+type SessionType byte
+
+const (
+	SessionTypeClosed SessionType = iota
+	SessionTypePreMarket
+	SessionTypeRegularMarket
+	SessionTypePostMarket
+)
+
+// MarketHours are the hours for a single product in a market on a single day
+type MarketHours struct {
+	Date         time.Time    `json:"-"` // Midnight in America/New_York
+	Market       Market       `json:"marketType"`
+	Product      string       `json:"product"`
+	ProductName  string       `json:"productName"`
+	Category     string       `json:"category"`
+	Exchange     string       `json:"exchange"`
+	IsOpen       bool         `json:"isOpen"`
+	SessionHours SessionHours `json:"sessionHours"`
+}
+
+type SessionHours struct {
+	PreMarket     []Session `json:"preMarket"`
+	RegularMarket []Session `json:"regularMarket"`
+	PostMarket    []Session `json:"postMarket"`
+}
+
+// Session is a window the market is open in. Futures sessions start the evening before Date
+type Session struct {
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+}
+
+func (s Session) contains(t time.Time) bool { return !t.Before(s.Start) && t.Before(s.End) }
+
+func (m *MarketHours) UnmarshalJSON(b []byte) error {
+	type marketHours MarketHours
+	x := struct {
+		*marketHours
+		Date string `json:"date"`
+	}{marketHours: (*marketHours)(m)}
+
+	if err := json.Unmarshal(b, &x); err != nil {
+		return err
+	}
+
+	if x.Date == "" {
+		return nil
+	}
+
+	loc, err := newYork()
+	if err != nil {
+		return err
+	}
+
+	if m.Date, err = time.ParseInLocation(chainDateFmt, x.Date, loc); err != nil {
+		return fmt.Errorf("invalid market hours date %s: %w", x.Date, err)
+	}
+
+	return nil
+}
+
+// session returns the session t falls in, if any
+func (m *MarketHours) session(t time.Time) SessionType {
+	for _, v := range []struct {
+		t        SessionType
+		sessions []Session
+	}{
+		{SessionTypePreMarket, m.SessionHours.PreMarket},
+		{SessionTypeRegularMarket, m.SessionHours.RegularMarket},
+		{SessionTypePostMarket, m.SessionHours.PostMarket},
+	} {
+		for _, s := range v.sessions {
+			if s.contains(t) {
+				return v.t
+			}
+		}
+	}
+
+	return SessionTypeClosed
+}
+
+func newYork() (*time.Location, error) {
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		return nil, fmt.Errorf("failed loading America/New_York, import time/tzdata if the system has no zoneinfo: %w", err)
+	}
+
+	return loc, nil
+}
+
+func marketHoursDate(date time.Time) string {
+	if date.IsZero() {
+		return ""
+	}
+
+	if loc, err := newYork(); err == nil {
+		date = date.In(loc)
+	}
+
+	return "date=" + date.Format(chainDateFmt)
+}
+
+// MarketHours returns hours for every product in each market on the date given; leave the date zero
+// for today. The result is keyed by market, then product (e.g. EQ for equities, EQO and IND for options).
+// On days a market is closed Schwab sends a single product with IsOpen false
+func (c *HTTPClient) MarketHours(ctx context.Context, date time.Time, markets ...Market) (map[Market]map[string]MarketHours, error) {
+	if len(markets) == 0 {
+		c.logger.ErrorContext(ctx, "missing markets for market hours")
+		return nil, ErrMissingMarket
+	}
+
+	s := make([]string, len(markets))
+	for i, v := range markets {
+		s[i] = v.String()
+	}
+
+	q := "markets=" + url.QueryEscape(strings.Join(s, ","))
+	if d := marketHoursDate(date); d != "" {
+		q += "&" + d
+	}
+
+	var m map[Market]map[string]MarketHours
+	if err := c.marketData(ctx, "/markets?"+q, &m); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// MarketHoursByMarket is MarketHours for a single market, keyed by product
+func (c *HTTPClient) MarketHoursByMarket(ctx context.Context, market Market, date time.Time) (map[string]MarketHours, error) {
+	if market == MarketUnspecified {
+		c.logger.ErrorContext(ctx, "missing market for market hours")
+		return nil, ErrMissingMarket
+	}
+
+	p := "/markets/" + market.String()
+	if d := marketHoursDate(date); d != "" {
+		p += "?" + d
+	}
+
+	var m map[Market]map[string]MarketHours
+	if err := c.marketData(ctx, p, &m); err != nil {
+		return nil, err
+	}
+
+	return m[market], nil
+}
@@ -0,0 +1,66 @@
+package td
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+var ErrInvalidMoversSort = errors.New("movers can only be sorted by volume, trades or percent change")
+
+// MoversReq picks how movers are ranked. The zero value ranks by
+// Schwab's default over the whole day
+type MoversReq struct {
+	Sort      ScreenerSort
+	Frequency ScreenerFrequency
+}
+
+func (r *MoversReq) validate() error {
+	switch {
+	case r.Sort == ScreenerSortAveragePercentVolume || (r.Sort != ScreenerSortUnspecified && !r.Sort.IsAScreenerSort()):
+		return fmt.Errorf("%w: got %d", ErrInvalidMoversSort, r.Sort)
+	case !r.Frequency.IsAScreenerFrequency():
+		return fmt.Errorf("%w: invalid frequency %d", ErrInvalidScreenerKey, r.Frequency)
+	default:
+		return nil
+	}
+}
+
+func (r *MoversReq) Encode() string {
+	q := url.Values{"frequency": {strconv.Itoa(int(r.Frequency))}}
+	if r.Sort != ScreenerSortUnspecified {
+		q.Set("sort", r.Sort.String())
+	}
+
+	return q.Encode()
+}
+
+// Movers is a REST snapshot of the top 10 movers in an index; the same data
+// the screener services stream. A nil req is the same as the zero value
+func (c *HTTPClient) Movers(ctx context.Context, index ScreenerIndex, req *MoversReq) ([]ScreenerItem, error) {
+	if index == ScreenerIndexUnspecified || int(index) >= len(screenerIndexNames) {
+		c.logger.ErrorContext(ctx, "invalid index for movers", "index", int(index))
+		return nil, fmt.Errorf("%w: invalid index %d", ErrInvalidScreenerKey, index)
+	}
+
+	if req == nil {
+		req = &MoversReq{}
+	}
+
+	if err := req.validate(); err != nil {
+		c.logger.ErrorContext(ctx, "invalid movers request", "err", err)
+		return nil, err
+	}
+
+	var m struct {
+		Screeners []ScreenerItem `json:"screeners"`
+	}
+
+	if err := c.marketData(ctx, "/movers/"+url.PathEscape(index.String())+"?"+req.Encode(), &m); err != nil {
+		return nil, err
+	}
+
+	return m.Screeners, nil
+}
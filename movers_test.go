@@ -0,0 +1,41 @@
+package td
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestMovers(t *testing.T) {
+	c := mockHTTP(t, "/movers/$SPX", "frequency=5&sort=PERCENT_CHANGE_UP", `{"screeners":[
+{"description":"NVIDIA CORP","volume":1200,"lastPrice":131.5,"netChange":4.2,"marketShare":3.1,"totalVolume":38000,"trades":900,"netPercentChange":0.033,"symbol":"NVDA"}]}`)
+
+	if _, err := c.Movers(context.Background(), ScreenerIndexSPX, &MoversReq{Sort: ScreenerSortAveragePercentVolume}); !errors.Is(err, ErrInvalidMoversSort) {
+		t.Errorf("average percent volume is screener only, got %v", err)
+	}
+
+	got, err := c.Movers(context.Background(), ScreenerIndexSPX, &MoversReq{
+		Sort:      ScreenerSortPercentChangeUp,
+		Frequency: ScreenerFrequency5Min,
+	})
+	if err != nil {
+		t.Fatalf("should not fail, got %s", err)
+	}
+
+	want := []ScreenerItem{{
+		Symbol:           "NVDA",
+		Description:      "NVIDIA CORP",
+		LastPrice:        131.5,
+		MarketShare:      3.1,
+		NetChange:        4.2,
+		NetPercentChange: 0.033,
+		TotalVolume:      38000,
+		Trades:           900,
+		Volume:           1200,
+	}}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("want %+v\ngot  %+v", want, got)
+	}
+}
@@ -0,0 +1,291 @@
+package td
+
+import (
+	"bufio"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+var (
+	ErrInvalidRedirectURL = errors.New("redirect URL must be https with a host to listen on")
+	ErrStateMismatch      = errors.New("oauth state in the callback doesn't match the request")
+	ErrMissingAuthCode    = errors.New("callback did not include an authorization code")
+)
+
+type authorizeConf struct {
+	prompt  func(authURL string)
+	browser bool
+	paste   io.Reader
+}
+
+type AuthorizeOpt func(*authorizeConf)
+
+// Show the authorize URL some other way than printing it to stderr. With
+// WithPastedRedirect, fn is also what should ask for the redirected URL
+func WithAuthPrompt(fn func(authURL string)) AuthorizeOpt {
+	return func(a *authorizeConf) { a.prompt = fn }
+}
+
+// Try opening the authorize URL in the default browser, on top of the prompt
+func WithBrowser() AuthorizeOpt { return func(a *authorizeConf) { a.browser = true } }
+
+// For headless boxes: instead of listening for the callback, read the URL the
+// browser was redirected to from r, e.g. os.Stdin. The page won't load, but the
+// URL in the address bar has the code in it
+func WithPastedRedirect(r io.Reader) AuthorizeOpt { return func(a *authorizeConf) { a.paste = r } }
+
+// AuthorizeCode runs the OAuth authorization code flow to get a brand new refresh
+// token, which is how you get one after the old one expires. It shows the authorize
+// URL, waits for the browser to hit the redirect URL (see WithRedirectURL) on a local
+// HTTPS listener with a self-signed certificate, exchanges the code and makes the
+// result the client's token. Expect a certificate warning in the browser; it's for
+// your own machine. The token is saved if the client has a TokenStore
+func (c *HTTPClient) AuthorizeCode(ctx context.Context, opts ...AuthorizeOpt) (oauth2.Token, error) {
+	var conf authorizeConf
+	for _, v := range opts {
+		v(&conf)
+	}
+
+	if conf.prompt == nil {
+		conf.prompt = func(authURL string) {
+			fmt.Fprintf(os.Stderr, "Log in to Schwab to authorize this app:\n\n%s\n\n", authURL)
+			if conf.paste != nil {
+				fmt.Fprint(os.Stderr, "Paste the URL the browser was redirected to: ")
+			}
+		}
+	}
+
+	l := c.logger.With("redirectURL", c.oauthConf.RedirectURL)
+
+	state := rand.Text()
+	authURL := c.oauthConf.AuthCodeURL(state)
+
+	var wait func(context.Context) (string, error)
+	if conf.paste != nil {
+		wait = func(ctx context.Context) (string, error) { return readPastedRedirect(ctx, conf.paste, state) }
+	} else {
+		var err error
+		if wait, err = listenForCallback(c.oauthConf.RedirectURL, state); err != nil {
+			l.ErrorContext(ctx, "failed starting callback listener", "err", err)
+			return oauth2.Token{}, err
+		}
+	}
+
+	conf.prompt(authURL)
+	if conf.browser {
+		if err := openBrowser(authURL); err != nil {
+			l.WarnContext(ctx, "failed opening browser", "err", err)
+		}
+	}
+
+	code, err := wait(ctx)
+	if err != nil {
+		l.ErrorContext(ctx, "failed getting authorization code", "err", err)
+		return oauth2.Token{}, err
+	}
+
+	tkn, err := c.oauthConf.Exchange(ctx, code)
+	if err != nil {
+		l.ErrorContext(ctx, "failed exchanging authorization code", "err", err)
+		return oauth2.Token{}, err
+	}
+
+	return c.useToken(ctx, l, withRefreshIssued(tkn, time.Now()))
+}
+
+// codeFromRedirect pulls the code out of the query the redirect URL was hit with
+func codeFromRedirect(q url.Values, state string) (string, error) {
+	if e := q.Get("error"); e != "" {
+		return "", fmt.Errorf("authorization failed: %s %s", e, q.Get("error_description"))
+	}
+
+	if q.Get("state") != state {
+		return "", ErrStateMismatch
+	}
+
+	code := q.Get("code")
+	if code == "" {
+		return "", ErrMissingAuthCode
+	}
+
+	return code, nil
+}
+
+func readPastedRedirect(ctx context.Context, r io.Reader, state string) (string, error) {
+	type result struct {
+		code string
+		err  error
+	}
+
+	ch := make(chan result, 1)
+	go func() {
+		line, err := bufio.NewReader(r).ReadString('\n')
+		if err != nil && (err != io.EOF || line == "") {
+			ch <- result{err: err}
+			return
+		}
+
+		u, err := url.Parse(strings.TrimSpace(line))
+		if err != nil {
+			ch <- result{err: err}
+			return
+		}
+
+		code, err := codeFromRedirect(u.Query(), state)
+		ch <- result{code, err}
+	}()
+
+	select {
+	case <-ctx.Done():
+		return "", ctx.Err()
+	case x := <-ch:
+		return x.code, x.err
+	}
+}
+
+// listenForCallback starts listening right away so the browser can't beat it,
+// and returns a function that waits for the code
+func listenForCallback(redirect, state string) (func(context.Context) (string, error), error) {
+	u, err := url.Parse(redirect)
+	if err != nil || u.Scheme != "https" || u.Hostname() == "" {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidRedirectURL, redirect)
+	}
+
+	addr := u.Host
+	if u.Port() == "" {
+		addr = net.JoinHostPort(u.Hostname(), "443")
+	}
+
+	cert, err := selfSignedCert(u.Hostname())
+	if err != nil {
+		return nil, err
+	}
+
+	ln, err := tls.Listen("tcp", addr, &tls.Config{Certificates: []tls.Certificate{cert}})
+	if err != nil {
+		// the default redirect is on 443, which needs root on most systems
+		return nil, fmt.Errorf("can't listen on %s for the OAuth callback, use WithRedirectURL with a free port above 1024 that's registered with your app: %w", addr, err)
+	}
+
+	path := u.Path
+	if path == "" {
+		path = "/"
+	}
+
+	type result struct {
+		code string
+		err  error
+	}
+
+	ch := make(chan result, 1)
+	mux := http.NewServeMux()
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		// browsers ask for things like favicons too, ignore them
+		q := r.URL.Query()
+		if !q.Has("code") && !q.Has("error") {
+			http.NotFound(w, r)
+			return
+		}
+
+		code, err := codeFromRedirect(q, state)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		} else {
+			fmt.Fprintln(w, "Authorized, you can close this window")
+		}
+
+		select {
+		case ch <- result{code, err}:
+		default:
+		}
+	})
+
+	srv := &http.Server{Handler: mux, ReadHeaderTimeout: 10 * time.Second}
+	go srv.Serve(ln)
+
+	return func(ctx context.Context) (string, error) {
+		defer srv.Close()
+
+		select {
+		case <-ctx.Done():
+			return "", ctx.Err()
+		case x := <-ch:
+			return x.code, x.err
+		}
+	}, nil
+}
+
+// selfSignedCert makes a throwaway certificate for the callback listener
+func selfSignedCert(host string) (tls.Certificate, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+
+	now := time.Now()
+	tmpl := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: host},
+		NotBefore:    now.Add(-time.Hour),
+		NotAfter:     now.Add(24 * time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+
+	if ip := net.ParseIP(host); ip != nil {
+		tmpl.IPAddresses = []net.IP{ip}
+	} else {
+		tmpl.DNSNames = []string{host}
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}, nil
+}
+
+func openBrowser(u string) error {
+	var cmd *exec.Cmd
+	switch runtime.GOOS {
+	case "darwin":
+		cmd = exec.Command("open", u)
+	case "windows":
+		cmd = exec.Command("rundll32", "url.dll,FileProtocolHandler", u)
+	default:
+		cmd = exec.Command("xdg-open", u)
+	}
+
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+
+	// the opener exits as soon as it hands off to the browser, reap it
+	go cmd.Wait()
+	return nil
+}
@@ -0,0 +1,129 @@
+package td
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+func mockAuthorizeClient(t *testing.T, redirect string) (*HTTPClient, context.Context) {
+	t.Helper()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		if r.Form.Get("grant_type") != "authorization_code" || r.Form.Get("code") != "abc" {
+			t.Errorf("unexpected token request %v", r.Form)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"access_token":"access","token_type":"Bearer","expires_in":1800,"refresh_token":"refresh"}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	c := &HTTPClient{
+		logger: slog.New(slog.DiscardHandler),
+		oauthConf: oauth2.Config{
+			ClientID:    "key",
+			RedirectURL: redirect,
+			Endpoint:    oauth2.Endpoint{AuthURL: AuthorizeURL, TokenURL: srv.URL, AuthStyle: oauth2.AuthStyleInParams},
+		},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	t.Cleanup(cancel)
+
+	return c, context.WithValue(ctx, oauth2.HTTPClient, srv.Client())
+}
+
+func stateFrom(t *testing.T, authURL string) string {
+	t.Helper()
+
+	u, err := url.Parse(authURL)
+	if err != nil {
+		t.Fatalf("invalid authorize URL %s: %s", authURL, err)
+	}
+
+	return u.Query().Get("state")
+}
+
+func TestAuthorizeCodePasted(t *testing.T) {
+	c, ctx := mockAuthorizeClient(t, "https://127.0.0.1")
+
+	r, w := io.Pipe()
+	tkn, err := c.AuthorizeCode(ctx, WithPastedRedirect(r), WithAuthPrompt(func(authURL string) {
+		go fmt.Fprintf(w, "https://127.0.0.1/?code=abc&state=%s\n", stateFrom(t, authURL))
+	}))
+	if err != nil {
+		t.Fatalf("should not fail, got %s", err)
+	}
+
+	if tkn.RefreshToken != "refresh" || tkn.AccessToken != "access" {
+		t.Errorf("token should come from the exchange, got %+v", tkn)
+	}
+
+	r, w = io.Pipe()
+	_, err = c.AuthorizeCode(ctx, WithPastedRedirect(r), WithAuthPrompt(func(string) {
+		go fmt.Fprintln(w, "https://127.0.0.1/?code=abc&state=forged")
+	}))
+	if err != ErrStateMismatch {
+		t.Errorf("should reject a mismatched state, got %v", err)
+	}
+}
+
+func TestAuthorizeCodeListener(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := ln.Addr().String()
+	ln.Close()
+
+	c, ctx := mockAuthorizeClient(t, "https://"+addr+"/callback")
+	browser := &http.Client{Transport: &http.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: true}}}
+
+	tkn, err := c.AuthorizeCode(ctx, WithAuthPrompt(func(authURL string) {
+		go func() {
+			resp, err := browser.Get(fmt.Sprintf("https://%s/callback?code=abc&state=%s", addr, stateFrom(t, authURL)))
+			if err != nil {
+				t.Errorf("callback listener should be up, got %s", err)
+				return
+			}
+			resp.Body.Close()
+		}()
+	}))
+	if err != nil {
+		t.Fatalf("should not fail, got %s", err)
+	}
+
+	if tkn.RefreshToken != "refresh" {
+		t.Errorf("token should come from the exchange, got %+v", tkn)
+	}
+
+	taken, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer taken.Close()
+
+	c.oauthConf.RedirectURL = "https://" + taken.Addr().String()
+	if _, err = c.AuthorizeCode(ctx, WithAuthPrompt(func(string) {})); err == nil || !strings.Contains(err.Error(), "WithRedirectURL") {
+		t.Errorf("bind failure should point at WithRedirectURL, got %v", err)
+	}
+
+	c.oauthConf.RedirectURL = "http://" + addr
+	if _, err = c.AuthorizeCode(ctx, WithAuthPrompt(func(string) {})); !errors.Is(err, ErrInvalidRedirectURL) {
+		t.Errorf("should fail when the redirect URL isn't https, got %v", err)
+	}
+}
@@ -0,0 +1,440 @@
+package td
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/go-querystring/query"
+)
+
+const chainDateFmt = "2006-01-02"
+
+var (
+	ErrAnalyticalOnly = errors.New("volatility, underlying price, interest rate and days to expiration are only used by the analytical strategy")
+	ErrChainStrategy  = errors.New("only the single and analytical strategies are supported, the others return a list of spreads (monthlyStrategyList) instead of a chain")
+)
+
+//go:generate enumer -type ContractType -trimprefix ContractType -json -transform upper
+type ContractType byte
+
+const (
+	ContractTypeUnspecified ContractType = iota
+	ContractTypeCall
+	ContractTypePut
+	ContractTypeAll
+)
+
+//go:generate enumer -type ChainStrategy -trimprefix ChainStrategy -json -transform upper
+type ChainStrategy byte
+
+const (
+	ChainStrategyUnspecified ChainStrategy = iota
+	ChainStrategySingle
+	ChainStrategyAnalytical // Calculates theoretical values using Volatility, UnderlyingPrice, InterestRate and DaysToExpiration
+	ChainStrategyCovered
+	ChainStrategyVertical
+	ChainStrategyCalendar
+	ChainStrategyStrangle
+	ChainStrategyStraddle
+	ChainStrategyButterfly
+	ChainStrategyCondor
+	ChainStrategyDiagonal
+	ChainStrategyCollar
+	ChainStrategyRoll
+)
+
+//go:generate enumer -type StrikeRange -trimprefix StrikeRange -json -transform upper
+type StrikeRange byte
+
+const (
+	StrikeRangeUnspecified StrikeRange = iota
+	StrikeRangeITM                     // In the money
+	StrikeRangeNTM                     // Near the money
+	StrikeRangeOTM                     // Out of the money
+	StrikeRangeSAK                     // Strikes above market
+	StrikeRangeSBK                     // Strikes below market
+	StrikeRangeSNK                     // Strikes near market
+	StrikeRangeAll
+)
+
+// OptionChainReq narrows down the chain. Everything is optional
+type OptionChainReq struct {
+	ContractType           ContractType
+	StrikeCount            int // Number of strikes above and below the at the money price
+	IncludeUnderlyingQuote bool
+	Strategy               ChainStrategy // Only single and analytical are supported, see ErrChainStrategy
+	Interval               float64       // Strike interval for spread strategies
+	Strike                 float64       // Only return this strike
+	Range                  StrikeRange
+
+	// Expiration window
+	From, To time.Time
+	ExpMonth time.Month
+
+	// Analytical inputs, only used with ChainStrategyAnalytical
+	Volatility       float64
+	UnderlyingPrice  float64
+	InterestRate     float64
+	DaysToExpiration int
+}
+
+func (r *OptionChainReq) validate() error {
+	analytical := r.Volatility != 0 || r.UnderlyingPrice != 0 || r.InterestRate != 0 || r.DaysToExpiration != 0
+
+	switch {
+	case r.Strategy > ChainStrategyAnalytical:
+		return fmt.Errorf("%w: got %s", ErrChainStrategy, r.Strategy)
+	case analytical && r.Strategy != ChainStrategyAnalytical:
+		return ErrAnalyticalOnly
+	case !r.From.IsZero() && !r.To.IsZero() && r.To.Before(r.From):
+		return ErrInvalidTimeRange
+	default:
+		return nil
+	}
+}
+
+func (r *OptionChainReq) Encode(symbol string) (string, error) {
+	date := func(t time.Time) string {
+		if t.IsZero() {
+			return ""
+		}
+
+		return t.Format(chainDateFmt)
+	}
+
+	var expMonth string
+	if r.ExpMonth != 0 {
+		expMonth = strings.ToUpper(r.ExpMonth.String()[:3])
+	}
+
+	req := struct {
+		Symbol                 string        `url:"symbol"`
+		ContractType           ContractType  `url:"contractType,omitempty"`
+		StrikeCount            int           `url:"strikeCount,omitempty"`
+		IncludeUnderlyingQuote bool          `url:"includeUnderlyingQuote,omitempty"`
+		Strategy               ChainStrategy `url:"strategy,omitempty"`
+		Interval               float64       `url:"interval,omitempty"`
+		Strike                 float64       `url:"strike,omitempty"`
+		Range                  StrikeRange   `url:"range,omitempty"`
+		FromDate               string        `url:"fromDate,omitempty"`
+		ToDate                 string        `url:"toDate,omitempty"`
+		ExpMonth               string        `url:"expMonth,omitempty"`
+		Volatility             float64       `url:"volatility,omitempty"`
+		UnderlyingPrice        float64       `url:"underlyingPrice,omitempty"`
+		InterestRate           float64       `url:"interestRate,omitempty"`
+		DaysToExpiration       int           `url:"daysToExpiration,omitempty"`
+	}{
+		Symbol:                 symbol,
+		ContractType:           r.ContractType,
+		StrikeCount:            r.StrikeCount,
+		IncludeUnderlyingQuote: r.IncludeUnderlyingQuote,
+		Strategy:               r.Strategy,
+		Interval:               r.Interval,
+		Strike:                 r.Strike,
+		Range:                  r.Range,
+		FromDate:               date(r.From),
+		ToDate:                 date(r.To),
+		ExpMonth:               expMonth,
+		Volatility:             r.Volatility,
+		UnderlyingPrice:        r.UnderlyingPrice,
+		InterestRate:           r.InterestRate,
+		DaysToExpiration:       r.DaysToExpiration,
+	}
+
+	q, err := query.Values(req)
+	if err != nil {
+		return "", err
+	}
+
+	return q.Encode(), nil
+}
+
+type OptionChain struct {
+	Symbol            string                 `json:"symbol"`
+	Status            string                 `json:"status"`
+	Underlying        *OptionChainUnderlying `json:"underlying"` // Only set with IncludeUnderlyingQuote
+	Strategy          ChainStrategy          `json:"strategy"`
+	Interval          float64                `json:"interval"`
+	IsDelayed         bool                   `json:"isDelayed"`
+	IsIndex           bool                   `json:"isIndex"`
+	DaysToExpiration  float64                `json:"daysToExpiration"`
+	InterestRate      float64                `json:"interestRate"`
+	UnderlyingPrice   float64                `json:"underlyingPrice"`
+	Volatility        float64                `json:"volatility"`
+	NumberOfContracts int                    `json:"numberOfContracts"`
+	Calls             OptionExpirations      `json:"callExpDateMap"`
+	Puts              OptionExpirations      `json:"putExpDateMap"`
+}
+
+func (o *OptionChain) UnmarshalJSON(b []byte) error {
+	type optionChain OptionChain
+	if err := json.Unmarshal(b, (*optionChain)(o)); err != nil {
+		return err
+	}
+
+	o.Calls.fillSide(OptionSideCall)
+	o.Puts.fillSide(OptionSidePut)
+	return nil
+}
+
+type OptionChainUnderlying struct {
+	Symbol            string  `json:"symbol"`
+	Description       string  `json:"description"`
+	ExchangeName      string  `json:"exchangeName"`
+	Bid               float64 `json:"bid"`
+	Ask               float64 `json:"ask"`
+	Last              float64 `json:"last"`
+	Mark              float64 `json:"mark"`
+	BidSize           int     `json:"bidSize"`
+	AskSize           int     `json:"askSize"`
+	Change            float64 `json:"change"`
+	PercentChange     float64 `json:"percentChange"`
+	MarkChange        float64 `json:"markChange"`
+	MarkPercentChange float64 `json:"markPercentChange"`
+	Close             float64 `json:"close"`
+	OpenPrice         float64 `json:"openPrice"`
+	HighPrice         float64 `json:"highPrice"`
+	LowPrice          float64 `json:"lowPrice"`
+	TotalVolume       int64   `json:"totalVolume"`
+	FiftyTwoWeekHigh  float64 `json:"fiftyTwoWeekHigh"`
+	FiftyTwoWeekLow   float64 `json:"fiftyTwoWeekLow"`
+	QuoteTime         int64   `json:"quoteTime"`
+	TradeTime         int64   `json:"tradeTime"`
+	Delayed           bool    `json:"delayed"`
+}
+
+// OptionExpirations holds contracts keyed by expiration date, then strike.
+// Expiration keys are midnight UTC on the expiration date
+type OptionExpirations map[time.Time]OptionStrikes
+
+// OptionStrikes holds contracts keyed by strike. Schwab sends a list per strike,
+// though it almost always has a single contract in it
+type OptionStrikes map[float64][]OptionContract
+
+func (o *OptionExpirations) UnmarshalJSON(b []byte) error {
+	var raw map[string]map[string][]OptionContract
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+
+	m := make(OptionExpirations, len(raw))
+	for k, strikes := range raw {
+		// keys look like 2025-01-17:30, the date then days to expiration
+		date, _, _ := strings.Cut(k, ":")
+		exp, err := time.Parse(chainDateFmt, date)
+		if err != nil {
+			return fmt.Errorf("invalid expiration %s in option chain: %w", k, err)
+		}
+
+		s := make(OptionStrikes, len(strikes))
+		for strike, contracts := range strikes {
+			f, err := strconv.ParseFloat(strike, 64)
+			if err != nil {
+				return fmt.Errorf("invalid strike %s in option chain: %w", strike, err)
+			}
+
+			s[f] = contracts
+		}
+
+		m[exp] = s
+	}
+
+	*o = m
+	return nil
+}
+
+// fillSide sets the side on contracts that came without a usable one
+func (o OptionExpirations) fillSide(side OptionSide) {
+	for _, strikes := range o {
+		for _, contracts := range strikes {
+			for i := range contracts {
+				if contracts[i].PutCall == OptionSideUnspecified {
+					contracts[i].PutCall = side
+				}
+			}
+		}
+	}
+}
+
+// Expirations in the map, soonest first
+func (o OptionExpirations) Expirations() []time.Time {
+	t := make([]time.Time, 0, len(o))
+	for k := range o {
+		t = append(t, k)
+	}
+
+	slices.SortFunc(t, func(a, b time.Time) int { return a.Compare(b) })
+	return t
+}
+
+// Strikes in the map, lowest first
+func (o OptionStrikes) Strikes() []float64 {
+	s := make([]float64, 0, len(o))
+	for k := range o {
+		s = append(s, k)
+	}
+
+	slices.Sort(s)
+	return s
+}
+
+type OptionContract struct {
+	PutCall                OptionSide               `json:"-"`
+	Symbol                 string                   `json:"symbol"`
+	Description            string                   `json:"description"`
+	ExchangeName           string                   `json:"exchangeName"`
+	OptionRoot             string                   `json:"optionRoot"`
+	StrikePrice            float64                  `json:"strikePrice"`
+	ExpirationDate         time.Time                `json:"expirationDate"`
+	DaysToExpiration       int                      `json:"daysToExpiration"`
+	ExpirationType         ExpirationType           `json:"expirationType"`
+	LastTradingDay         int64                    `json:"lastTradingDay"`
+	Multiplier             float64                  `json:"multiplier"`
+	SettlementType         SettlementType           `json:"settlementType"`
+	DeliverableNote        string                   `json:"deliverableNote"`
+	IsMini                 bool                     `json:"isMini"`
+	IsNonStandard          bool                     `json:"isNonStandard"`
+	IsPennyPilot           bool                     `json:"isPennyPilot"`
+	IsInTheMoney           bool                     `json:"isInTheMoney"`
+	Bid                    float64                  `json:"bid"`
+	Ask                    float64                  `json:"ask"`
+	Last                   float64                  `json:"last"`
+	Mark                   float64                  `json:"mark"`
+	BidSize                int                      `json:"bidSize"`
+	AskSize                int                      `json:"askSize"`
+	LastSize               int                      `json:"lastSize"`
+	HighPrice              float64                  `json:"highPrice"`
+	LowPrice               float64                  `json:"lowPrice"`
+	OpenPrice              float64                  `json:"openPrice"`
+	ClosePrice             float64                  `json:"closePrice"`
+	High52Week             float64                  `json:"high52Week"`
+	Low52Week              float64                  `json:"low52Week"`
+	TotalVolume            int64                    `json:"totalVolume"`
+	OpenInterest           int64                    `json:"openInterest"`
+	NetChange              float64                  `json:"netChange"`
+	PercentChange          float64                  `json:"percentChange"`
+	MarkChange             float64                  `json:"markChange"`
+	MarkPercentChange      float64                  `json:"markPercentChange"`
+	QuoteTimeInLong        int64                    `json:"quoteTimeInLong"`
+	TradeTimeInLong        int64                    `json:"tradeTimeInLong"`
+	Volatility             float64                  `json:"volatility"`
+	Delta                  float64                  `json:"delta"`
+	Gamma                  float64                  `json:"gamma"`
+	Theta                  float64                  `json:"theta"`
+	Vega                   float64                  `json:"vega"`
+	Rho                    float64                  `json:"rho"`
+	TimeValue              float64                  `json:"timeValue"`
+	IntrinsicValue         float64                  `json:"intrinsicValue"`
+	ExtrinsicValue         float64                  `json:"extrinsicValue"`
+	TheoreticalOptionValue float64                  `json:"theoreticalOptionValue"`
+	TheoreticalVolatility  float64                  `json:"theoreticalVolatility"`
+	OptionDeliverablesList []OptionChainDeliverable `json:"optionDeliverablesList"`
+}
+
+type OptionChainDeliverable struct {
+	Symbol           string  `json:"symbol"`
+	AssetType        string  `json:"assetType"`
+	DeliverableUnits float64 `json:"deliverableUnits"`
+	CurrencyType     string  `json:"currencyType"`
+}
+
+func (o *OptionContract) UnmarshalJSON(b []byte) error {
+	type contract OptionContract
+	x := struct {
+		*contract
+		PutCall string `json:"putCall"`
+	}{contract: (*contract)(o)}
+
+	if err := json.Unmarshal(b, &x); err != nil {
+		return err
+	}
+
+	// anything else is left unspecified for the chain to fill in from the
+	// map the contract came from, rather than failing the whole chain
+	switch x.PutCall {
+	case "CALL":
+		o.PutCall = OptionSideCall
+	case "PUT":
+		o.PutCall = OptionSidePut
+	}
+
+	return nil
+}
+
+// OptionID for the contract, e.g. to pass to SetOptionSubscription
+func (o *OptionContract) OptionID() OptionID {
+	root := o.OptionRoot
+	if root == "" && len(o.Symbol) >= 6 {
+		root = strings.TrimSpace(o.Symbol[:6])
+	}
+
+	exp := o.ExpirationDate.UTC()
+	return OptionID{
+		Symbol:     root,
+		Expiration: time.Date(exp.Year(), exp.Month(), exp.Day(), 0, 0, 0, 0, time.UTC),
+		Side:       o.PutCall,
+		Strike:     o.StrikePrice,
+	}
+}
+
+// OptionIDs for every contract in the chain, calls then puts,
+// each sorted by expiration then strike
+func (o *OptionChain) OptionIDs() []OptionID {
+	var ids []OptionID
+	for _, m := range []OptionExpirations{o.Calls, o.Puts} {
+		start := len(ids)
+		for _, strikes := range m {
+			for _, contracts := range strikes {
+				for i := range contracts {
+					ids = append(ids, contracts[i].OptionID())
+				}
+			}
+		}
+
+		slices.SortFunc(ids[start:], func(a, b OptionID) int {
+			if c := a.Expiration.Compare(b.Expiration); c != 0 {
+				return c
+			}
+
+			return cmp.Compare(a.Strike, b.Strike)
+		})
+	}
+
+	return ids
+}
+
+// OptionChain fetches the option chain for symbol. The request is optional;
+// pass nil for every contract Schwab has
+func (c *HTTPClient) OptionChain(ctx context.Context, symbol string, req *OptionChainReq) (*OptionChain, error) {
+	if symbol == "" {
+		c.logger.ErrorContext(ctx, "missing symbol for option chain")
+		return nil, ErrMissingSymbol
+	}
+
+	if req == nil {
+		req = &OptionChainReq{}
+	}
+
+	if err := req.validate(); err != nil {
+		return nil, err
+	}
+
+	encode, err := req.Encode(symbol)
+	if err != nil {
+		return nil, err
+	}
+
+	chain := new(OptionChain)
+	if err = c.marketData(ctx, fmt.Sprintf("/chains?%s", encode), chain); err != nil {
+		return nil, err
+	}
+
+	return chain, nil
+}
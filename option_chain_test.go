@@ -0,0 +1,66 @@
+package td
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestOptionChain(t *testing.T) {
+	c := mockHTTP(t, "/chains", "contractType=ALL&range=NTM&strikeCount=2&symbol=AAPL&toDate=2025-02-01", `{
+"symbol":"AAPL","status":"SUCCESS","strategy":"SINGLE","interval":0,"isDelayed":false,"isIndex":false,"underlyingPrice":229.5,"numberOfContracts":3,
+"callExpDateMap":{
+	"2025-01-17:10":{
+		"230.0":[{"putCall":"CALL","symbol":"AAPL  250117C00230000","optionRoot":"AAPL","strikePrice":230,"expirationDate":"2025-01-17T21:00:00.000+00:00","daysToExpiration":10,"bid":3.1,"ask":3.2,"delta":0.49}],
+		"225.0":[{"putCall":"","symbol":"AAPL  250117C00225000","optionRoot":"AAPL","strikePrice":225,"expirationDate":"2025-01-17T21:00:00.000+00:00","daysToExpiration":10,"bid":6.1,"ask":6.3,"delta":0.71}]
+	}
+},
+"putExpDateMap":{
+	"2025-01-10:3":{
+		"230.0":[{"putCall":"STRADDLE","symbol":"AAPL  250110P00230000","strikePrice":230,"expirationDate":"2025-01-10T21:00:00.000+00:00","daysToExpiration":3,"bid":1.5,"ask":1.6,"delta":-0.5}]
+	}
+}}`)
+
+	if _, err := c.OptionChain(context.Background(), "AAPL", &OptionChainReq{Volatility: 30}); err != ErrAnalyticalOnly {
+		t.Errorf("analytical inputs without the analytical strategy should fail, got %v", err)
+	}
+
+	if _, err := c.OptionChain(context.Background(), "AAPL", &OptionChainReq{Strategy: ChainStrategyVertical}); !errors.Is(err, ErrChainStrategy) {
+		t.Errorf("spread strategies should be rejected, got %v", err)
+	}
+
+	chain, err := c.OptionChain(context.Background(), "AAPL", &OptionChainReq{
+		ContractType: ContractTypeAll,
+		StrikeCount:  2,
+		Range:        StrikeRangeNTM,
+		To:           time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC),
+	})
+	if err != nil {
+		t.Fatalf("should not fail, got %s", err)
+	}
+
+	jan17 := time.Date(2025, 1, 17, 0, 0, 0, 0, time.UTC)
+	if got := chain.Calls.Expirations(); !reflect.DeepEqual(got, []time.Time{jan17}) {
+		t.Errorf("want a single call expiration on %s, got %v", jan17, got)
+	}
+
+	if got := chain.Calls[jan17].Strikes(); !reflect.DeepEqual(got, []float64{225, 230}) {
+		t.Errorf("strikes should be sorted, got %v", got)
+	}
+
+	if got := chain.Calls[jan17][230][0]; got.PutCall != OptionSideCall || got.Delta != 0.49 {
+		t.Errorf("contract decoded incorrectly: %+v", got)
+	}
+
+	want := []OptionID{
+		{Symbol: "AAPL", Expiration: jan17, Side: OptionSideCall, Strike: 225},
+		{Symbol: "AAPL", Expiration: jan17, Side: OptionSideCall, Strike: 230},
+		{Symbol: "AAPL", Expiration: time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC), Side: OptionSidePut, Strike: 230},
+	}
+
+	if got := chain.OptionIDs(); !reflect.DeepEqual(got, want) {
+		t.Errorf("want %+v\ngot  %+v", want, got)
+	}
+}
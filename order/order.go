@@ -0,0 +1,288 @@
+// Package order builds td.Order values for common equity and option
+// strategies. Every builder is checked when calling Build, so mistakes like
+// mismatched expirations or a limit order with no price never reach Schwab
+//
+//	o, err := order.Equity("AAPL", td.InstructionBuy, 10).Limit(150.25).GTC().Build()
+//	o, err := order.Vertical(long, short, 1).Debit(1.2).Build()
+//	o, err := order.Bracket(entry, takeProfit, stopLoss).Build()
+package order
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/AnthonyHewins/td"
+)
+
+var (
+	ErrWrongLegCount        = errors.New("wrong number of legs for strategy")
+	ErrMismatchedUnderlying = errors.New("legs must share an underlying")
+	ErrMismatchedExpiration = errors.New("legs must share an expiration")
+	ErrMismatchedSide       = errors.New("legs have the wrong mix of calls and puts")
+	ErrInvalidRatio         = errors.New("leg quantities are in the wrong ratio")
+	ErrInvalidStrikes       = errors.New("leg strikes are invalid for strategy")
+	ErrInvalidInstructions  = errors.New("leg instructions are invalid for strategy")
+	ErrMissingChild         = errors.New("missing child order")
+	ErrNotSingleLegOrder    = errors.New("only single leg orders can use this order type")
+	ErrNotMultiLegOrder     = errors.New("only multi leg orders can use net pricing")
+	ErrTriggerOnOCO         = errors.New("can't attach children to an OCO order")
+)
+
+// Builder is a fluent td.Order builder. Start one with Equity, Option, Options
+// or one of the strategy helpers, set the order type and then call Build.
+// Orders default to a DAY order in the normal session
+type Builder struct {
+	o   td.Order
+	err error
+
+	// kept around to check multi leg strategies in Build
+	options  []OptionLeg
+	children []*Builder
+}
+
+// OptionLeg is a single leg of an options order
+type OptionLeg struct {
+	ID          td.OptionID
+	Instruction td.Instruction
+	Quantity    float64
+}
+
+func newBuilder(strategy td.ComplexOrderStrategyType, legs ...td.OrderLeg) *Builder {
+	return &Builder{o: td.Order{
+		Session:                  td.OrderSessionNormal,
+		Duration:                 td.OrderDurationDay,
+		OrderStrategyType:        td.OrderStrategyTypeSingle,
+		ComplexOrderStrategyType: strategy,
+		OrderLegCollection:       legs,
+	}}
+}
+
+// Equity starts an order for a stock or ETF
+func Equity(symbol string, instruction td.Instruction, quantity float64) *Builder {
+	return newBuilder(td.ComplexOrderStrategyTypeNone, td.OrderLeg{
+		OrderLegType: td.AssetTypeEquity,
+		Instrument:   td.Instrument{Symbol: symbol, AssetType: td.AssetTypeEquity},
+		Instruction:  instruction,
+		Quantity:     quantity,
+	})
+}
+
+// Option starts a single leg option order
+func Option(id td.OptionID, instruction td.Instruction, quantity float64) *Builder {
+	return Options(td.ComplexOrderStrategyTypeNone, OptionLeg{ID: id, Instruction: instruction, Quantity: quantity})
+}
+
+// Options starts a multi leg option order. Vertical, Straddle, Strangle and IronCondor
+// cover the common cases; use this directly for anything else, like closing a spread.
+// Legs are checked against the strategy when calling Build
+func Options(strategy td.ComplexOrderStrategyType, legs ...OptionLeg) *Builder {
+	orderLegs := make([]td.OrderLeg, len(legs))
+	var err error
+	for i, v := range legs {
+		if e := v.ID.Validate(); e != nil && err == nil {
+			err = fmt.Errorf("leg %d: %w", i, e)
+		}
+
+		orderLegs[i] = td.OrderLeg{
+			OrderLegType: td.AssetTypeOption,
+			Instrument:   td.Instrument{Symbol: v.ID.String(), AssetType: td.AssetTypeOption},
+			Instruction:  v.Instruction,
+			Quantity:     v.Quantity,
+		}
+	}
+
+	b := newBuilder(strategy, orderLegs...)
+	b.options, b.err = legs, err
+	return b
+}
+
+// Vertical opens a vertical spread: buy one strike and sell another on the
+// same side and expiration. Whether it's a debit or credit spread depends on the strikes
+func Vertical(buy, sell td.OptionID, quantity float64) *Builder {
+	return Options(
+		td.ComplexOrderStrategyTypeVertical,
+		OptionLeg{ID: buy, Instruction: td.InstructionBuyToOpen, Quantity: quantity},
+		OptionLeg{ID: sell, Instruction: td.InstructionSellToOpen, Quantity: quantity},
+	)
+}
+
+// Straddle opens a call and a put at the same strike and expiration.
+// Use td.InstructionBuyToOpen for a long straddle, td.InstructionSellToOpen for a short one
+func Straddle(call, put td.OptionID, instruction td.Instruction, quantity float64) *Builder {
+	return Options(
+		td.ComplexOrderStrategyTypeStraddle,
+		OptionLeg{ID: call, Instruction: instruction, Quantity: quantity},
+		OptionLeg{ID: put, Instruction: instruction, Quantity: quantity},
+	)
+}
+
+// Strangle opens a call and a put at different strikes with the same expiration.
+// Use td.InstructionBuyToOpen for a long strangle, td.InstructionSellToOpen for a short one
+func Strangle(call, put td.OptionID, instruction td.Instruction, quantity float64) *Builder {
+	return Options(
+		td.ComplexOrderStrategyTypeStrangle,
+		OptionLeg{ID: call, Instruction: instruction, Quantity: quantity},
+		OptionLeg{ID: put, Instruction: instruction, Quantity: quantity},
+	)
+}
+
+// IronCondor opens a short iron condor: a put credit spread below a call credit spread.
+// Strikes must go longPut < shortPut < shortCall < longCall
+func IronCondor(longPut, shortPut, shortCall, longCall td.OptionID, quantity float64) *Builder {
+	return Options(
+		td.ComplexOrderStrategyTypeIronCondor,
+		OptionLeg{ID: longPut, Instruction: td.InstructionBuyToOpen, Quantity: quantity},
+		OptionLeg{ID: shortPut, Instruction: td.InstructionSellToOpen, Quantity: quantity},
+		OptionLeg{ID: shortCall, Instruction: td.InstructionSellToOpen, Quantity: quantity},
+		OptionLeg{ID: longCall, Instruction: td.InstructionBuyToOpen, Quantity: quantity},
+	)
+}
+
+// OCO places every order at once; when one fills the rest are canceled.
+// The children need their order types set
+func OCO(orders ...*Builder) *Builder {
+	return &Builder{
+		o:        td.Order{OrderStrategyType: td.OrderStrategyTypeOCO},
+		children: orders,
+	}
+}
+
+// Bracket places entry, and once it fills, places takeProfit and stopLoss as an OCO pair
+func Bracket(entry, takeProfit, stopLoss *Builder) *Builder {
+	if entry == nil {
+		return &Builder{err: fmt.Errorf("bracket entry: %w", ErrMissingChild)}
+	}
+
+	return entry.Then(OCO(takeProfit, stopLoss))
+}
+
+// Then makes this a one-triggers-other (OTO) order: the orders passed are only
+// placed once this one fills
+func (b *Builder) Then(orders ...*Builder) *Builder {
+	if b.o.OrderStrategyType == td.OrderStrategyTypeOCO {
+		b.setErr(ErrTriggerOnOCO)
+		return b
+	}
+
+	b.o.OrderStrategyType = td.OrderStrategyTypeTrigger
+	b.children = append(b.children, orders...)
+	return b
+}
+
+func (b *Builder) setErr(err error) {
+	if b.err == nil {
+		b.err = err
+	}
+}
+
+func (b *Builder) singleLeg() bool { return len(b.o.OrderLegCollection) == 1 }
+
+func (b *Builder) Market() *Builder {
+	b.o.OrderType = td.OrderTypeMarket
+	return b
+}
+
+// Limit sets a limit order. Multi leg orders should use Debit, Credit or Even
+func (b *Builder) Limit(price float64) *Builder {
+	if !b.singleLeg() {
+		b.setErr(fmt.Errorf("%w: limit", ErrNotSingleLegOrder))
+	}
+
+	b.o.OrderType, b.o.Price = td.OrderTypeLimit, price
+	return b
+}
+
+func (b *Builder) Stop(stopPrice float64) *Builder {
+	if !b.singleLeg() {
+		b.setErr(fmt.Errorf("%w: stop", ErrNotSingleLegOrder))
+	}
+
+	b.o.OrderType, b.o.StopPrice = td.OrderTypeStop, stopPrice
+	return b
+}
+
+func (b *Builder) StopLimit(stopPrice, limitPrice float64) *Builder {
+	if !b.singleLeg() {
+		b.setErr(fmt.Errorf("%w: stop limit", ErrNotSingleLegOrder))
+	}
+
+	b.o.OrderType, b.o.StopPrice, b.o.Price = td.OrderTypeStopLimit, stopPrice, limitPrice
+	return b
+}
+
+// TrailingStop trails the last price by offset, either a dollar amount
+// with td.PriceLinkTypeValue or a percentage with td.PriceLinkTypePercent
+func (b *Builder) TrailingStop(linkType td.PriceLinkType, offset float64) *Builder {
+	if !b.singleLeg() {
+		b.setErr(fmt.Errorf("%w: trailing stop", ErrNotSingleLegOrder))
+	}
+
+	b.o.OrderType = td.OrderTypeTrailingStop
+	b.o.StopPriceLinkBasis = td.PriceLinkBasisLast
+	b.o.StopPriceLinkType = linkType
+	b.o.StopPriceOffset = offset
+	return b
+}
+
+// Debit prices a multi leg order at a net debit: the most you'll pay
+func (b *Builder) Debit(price float64) *Builder { return b.net(td.OrderTypeNetDebit, price) }
+
+// Credit prices a multi leg order at a net credit: the least you'll receive
+func (b *Builder) Credit(price float64) *Builder { return b.net(td.OrderTypeNetCredit, price) }
+
+// Even prices a multi leg order at zero net cost
+func (b *Builder) Even() *Builder { return b.net(td.OrderTypeNetZero, 0) }
+
+func (b *Builder) net(t td.OrderType, price float64) *Builder {
+	if b.singleLeg() {
+		b.setErr(fmt.Errorf("%w: %s", ErrNotMultiLegOrder, t))
+	}
+
+	b.o.OrderType, b.o.Price = t, price
+	return b
+}
+
+func (b *Builder) Duration(d td.OrderDuration) *Builder {
+	b.o.Duration = d
+	return b
+}
+
+func (b *Builder) Day() *Builder { return b.Duration(td.OrderDurationDay) }
+func (b *Builder) GTC() *Builder { return b.Duration(td.OrderDurationGoodTillCancel) }
+
+func (b *Builder) Session(s td.OrderSession) *Builder {
+	b.o.Session = s
+	return b
+}
+
+// Build checks the order and everything attached to it, returning the first problem found
+func (b *Builder) Build() (*td.Order, error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+
+	if err := b.validateStrategy(); err != nil {
+		return nil, err
+	}
+
+	o := b.o
+	o.ChildOrderStrategies = nil
+	for i, v := range b.children {
+		if v == nil {
+			return nil, fmt.Errorf("child order %d: %w", i, ErrMissingChild)
+		}
+
+		child, err := v.Build()
+		if err != nil {
+			return nil, fmt.Errorf("child order %d: %w", i, err)
+		}
+
+		o.ChildOrderStrategies = append(o.ChildOrderStrategies, *child)
+	}
+
+	if err := o.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &o, nil
+}
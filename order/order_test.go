@@ -0,0 +1,125 @@
+package order
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/AnthonyHewins/td"
+)
+
+var expiration = time.Date(2027, 1, 15, 0, 0, 0, 0, time.UTC)
+
+func opt(side td.OptionSide, strike float64) td.OptionID {
+	return td.OptionID{Symbol: "SPY", Expiration: expiration, Side: side, Strike: strike}
+}
+
+func TestBuild(mainTest *testing.T) {
+	otherExpiration := opt(td.OptionSideCall, 510)
+	otherExpiration.Expiration = expiration.AddDate(0, 1, 0)
+
+	testCases := []struct {
+		name     string
+		arg      *Builder
+		expected error
+	}{
+		{"equity market", Equity("AAPL", td.InstructionBuy, 10).Market(), nil},
+		{"equity limit", Equity("AAPL", td.InstructionBuy, 10).Limit(150).GTC(), nil},
+		{"equity limit missing price", Equity("AAPL", td.InstructionBuy, 10).Limit(0), td.ErrMissingPrice},
+		{"equity stop limit", Equity("AAPL", td.InstructionSell, 10).StopLimit(140, 139.5), nil},
+		{"equity stop missing price", Equity("AAPL", td.InstructionSell, 10).Stop(0), td.ErrMissingStopPrice},
+		{"trailing stop", Equity("AAPL", td.InstructionSell, 10).TrailingStop(td.PriceLinkTypePercent, 5), nil},
+		{"missing order type", Equity("AAPL", td.InstructionBuy, 10), td.ErrMissingOrderType},
+		{"single option", Option(opt(td.OptionSideCall, 500), td.InstructionBuyToOpen, 1).Limit(2.5), nil},
+		{"invalid option", Option(td.OptionID{Symbol: "SPY"}, td.InstructionBuyToOpen, 1).Limit(2.5), td.ErrMissingExpiration},
+		{"vertical", Vertical(opt(td.OptionSideCall, 500), opt(td.OptionSideCall, 510), 1).Debit(4), nil},
+		{"vertical with limit", Vertical(opt(td.OptionSideCall, 500), opt(td.OptionSideCall, 510), 1).Limit(4), ErrNotSingleLegOrder},
+		{"vertical missing price", Vertical(opt(td.OptionSideCall, 500), opt(td.OptionSideCall, 510), 1).Debit(0), td.ErrMissingPrice},
+		{"vertical mixed sides", Vertical(opt(td.OptionSideCall, 500), opt(td.OptionSidePut, 510), 1).Debit(4), ErrMismatchedSide},
+		{"vertical same strike", Vertical(opt(td.OptionSideCall, 500), opt(td.OptionSideCall, 500), 1).Debit(4), ErrInvalidStrikes},
+		{"vertical mismatched expiration", Vertical(opt(td.OptionSideCall, 500), otherExpiration, 1).Debit(4), ErrMismatchedExpiration},
+		{"straddle", Straddle(opt(td.OptionSideCall, 500), opt(td.OptionSidePut, 500), td.InstructionBuyToOpen, 1).Debit(20), nil},
+		{"straddle different strikes", Straddle(opt(td.OptionSideCall, 505), opt(td.OptionSidePut, 500), td.InstructionBuyToOpen, 1).Debit(20), ErrInvalidStrikes},
+		{"strangle", Strangle(opt(td.OptionSideCall, 510), opt(td.OptionSidePut, 490), td.InstructionSellToOpen, 1).Credit(8), nil},
+		{"strangle two calls", Strangle(opt(td.OptionSideCall, 510), opt(td.OptionSideCall, 490), td.InstructionSellToOpen, 1).Credit(8), ErrMismatchedSide},
+		{
+			"iron condor",
+			IronCondor(opt(td.OptionSidePut, 480), opt(td.OptionSidePut, 490), opt(td.OptionSideCall, 510), opt(td.OptionSideCall, 520), 1).Credit(3),
+			nil,
+		},
+		{
+			"iron condor crossed strikes",
+			IronCondor(opt(td.OptionSidePut, 490), opt(td.OptionSidePut, 480), opt(td.OptionSideCall, 510), opt(td.OptionSideCall, 520), 1).Credit(3),
+			ErrInvalidStrikes,
+		},
+		{
+			"wrong ratio",
+			Options(
+				td.ComplexOrderStrategyTypeVertical,
+				OptionLeg{ID: opt(td.OptionSideCall, 500), Instruction: td.InstructionBuyToOpen, Quantity: 1},
+				OptionLeg{ID: opt(td.OptionSideCall, 510), Instruction: td.InstructionSellToOpen, Quantity: 2},
+			).Debit(4),
+			ErrInvalidRatio,
+		},
+		{
+			"oco",
+			OCO(Equity("AAPL", td.InstructionSell, 10).Limit(160), Equity("AAPL", td.InstructionSell, 10).Stop(140)),
+			nil,
+		},
+		{
+			"oco with invalid child",
+			OCO(Equity("AAPL", td.InstructionSell, 10).Limit(160), Equity("AAPL", td.InstructionSell, 10)),
+			td.ErrMissingOrderType,
+		},
+		{
+			"bracket",
+			Bracket(
+				Equity("AAPL", td.InstructionBuy, 10).Limit(150),
+				Equity("AAPL", td.InstructionSell, 10).Limit(160),
+				Equity("AAPL", td.InstructionSell, 10).Stop(140),
+			),
+			nil,
+		},
+		{"trigger on oco", OCO().Then(Equity("AAPL", td.InstructionSell, 10).Limit(160)), ErrTriggerOnOCO},
+	}
+
+	for _, tc := range testCases {
+		mainTest.Run(tc.name, func(tt *testing.T) {
+			_, err := tc.arg.Build()
+			if tc.expected == nil {
+				if err != nil {
+					tt.Errorf("should not fail, got %s", err)
+				}
+				return
+			}
+
+			if !errors.Is(err, tc.expected) {
+				tt.Errorf("want %s, got %v", tc.expected, err)
+			}
+		})
+	}
+}
+
+func TestBracketShape(t *testing.T) {
+	o, err := Bracket(
+		Equity("AAPL", td.InstructionBuy, 10).Limit(150),
+		Equity("AAPL", td.InstructionSell, 10).Limit(160).GTC(),
+		Equity("AAPL", td.InstructionSell, 10).Stop(140).GTC(),
+	).Build()
+	if err != nil {
+		t.Fatalf("should not fail, got %s", err)
+	}
+
+	if o.OrderStrategyType != td.OrderStrategyTypeTrigger || len(o.ChildOrderStrategies) != 1 {
+		t.Fatalf("entry should trigger a single child, got %s with %d children", o.OrderStrategyType, len(o.ChildOrderStrategies))
+	}
+
+	oco := o.ChildOrderStrategies[0]
+	if oco.OrderStrategyType != td.OrderStrategyTypeOCO || len(oco.ChildOrderStrategies) != 2 {
+		t.Fatalf("child should be an OCO pair, got %s with %d children", oco.OrderStrategyType, len(oco.ChildOrderStrategies))
+	}
+
+	if got := oco.ChildOrderStrategies[1]; got.OrderType != td.OrderTypeStop || got.StopPrice != 140 || got.Duration != td.OrderDurationGoodTillCancel {
+		t.Errorf("stop loss should be a GTC stop at 140, got %+v", got)
+	}
+}
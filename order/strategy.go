@@ -0,0 +1,110 @@
+package order
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/AnthonyHewins/td"
+)
+
+func isBuy(i td.Instruction) bool {
+	switch i {
+	case td.InstructionBuy, td.InstructionBuyToOpen, td.InstructionBuyToClose, td.InstructionBuyToCover:
+		return true
+	default:
+		return false
+	}
+}
+
+// validateStrategy checks the option legs make up the strategy the order
+// claims to be. Strategies without a helper in this package aren't checked
+func (b *Builder) validateStrategy() error {
+	legs := b.options
+	if len(legs) < 2 {
+		return nil
+	}
+
+	want := 0
+	switch b.o.ComplexOrderStrategyType {
+	case td.ComplexOrderStrategyTypeVertical, td.ComplexOrderStrategyTypeStraddle, td.ComplexOrderStrategyTypeStrangle:
+		want = 2
+	case td.ComplexOrderStrategyTypeIronCondor:
+		want = 4
+	default:
+		return nil
+	}
+
+	if len(legs) != want {
+		return fmt.Errorf("%w: %s needs %d, got %d", ErrWrongLegCount, b.o.ComplexOrderStrategyType, want, len(legs))
+	}
+
+	first := legs[0]
+	for _, v := range legs[1:] {
+		switch {
+		case v.ID.Symbol != first.ID.Symbol:
+			return fmt.Errorf("%w: %s and %s", ErrMismatchedUnderlying, first.ID.Symbol, v.ID.Symbol)
+		case !v.ID.Expiration.Equal(first.ID.Expiration):
+			return fmt.Errorf("%w: %s and %s", ErrMismatchedExpiration, first.ID.Expiration.Format(time.DateOnly), v.ID.Expiration.Format(time.DateOnly))
+		case v.Quantity != first.Quantity:
+			return fmt.Errorf("%w: %s legs must be 1:1, got %v and %v", ErrInvalidRatio, b.o.ComplexOrderStrategyType, first.Quantity, v.Quantity)
+		}
+	}
+
+	switch b.o.ComplexOrderStrategyType {
+	case td.ComplexOrderStrategyTypeVertical:
+		return validateVertical(legs[0], legs[1])
+	case td.ComplexOrderStrategyTypeStraddle:
+		return validateStraddle(legs[0], legs[1], true)
+	case td.ComplexOrderStrategyTypeStrangle:
+		return validateStraddle(legs[0], legs[1], false)
+	default:
+		return validateIronCondor(legs)
+	}
+}
+
+func validateVertical(a, b OptionLeg) error {
+	switch {
+	case a.ID.Side != b.ID.Side:
+		return fmt.Errorf("%w: vertical legs must both be calls or both be puts", ErrMismatchedSide)
+	case a.ID.Strike == b.ID.Strike:
+		return fmt.Errorf("%w: vertical legs can't share a strike", ErrInvalidStrikes)
+	case isBuy(a.Instruction) == isBuy(b.Instruction):
+		return fmt.Errorf("%w: vertical needs one buy and one sell", ErrInvalidInstructions)
+	default:
+		return nil
+	}
+}
+
+// validateStraddle checks straddles, and strangles when sameStrike is false
+func validateStraddle(a, b OptionLeg, sameStrike bool) error {
+	switch {
+	case a.ID.Side == b.ID.Side:
+		return fmt.Errorf("%w: needs one call and one put", ErrMismatchedSide)
+	case sameStrike && a.ID.Strike != b.ID.Strike:
+		return fmt.Errorf("%w: straddle legs must share a strike", ErrInvalidStrikes)
+	case !sameStrike && a.ID.Strike == b.ID.Strike:
+		return fmt.Errorf("%w: strangle legs can't share a strike, use a straddle", ErrInvalidStrikes)
+	case a.Instruction != b.Instruction:
+		return fmt.Errorf("%w: both legs must be bought or both sold", ErrInvalidInstructions)
+	default:
+		return nil
+	}
+}
+
+// validateIronCondor expects the legs in the order IronCondor passes them:
+// long put, short put, short call, long call
+func validateIronCondor(legs []OptionLeg) error {
+	longPut, shortPut, shortCall, longCall := legs[0], legs[1], legs[2], legs[3]
+
+	switch {
+	case longPut.ID.Side != td.OptionSidePut || shortPut.ID.Side != td.OptionSidePut ||
+		shortCall.ID.Side != td.OptionSideCall || longCall.ID.Side != td.OptionSideCall:
+		return fmt.Errorf("%w: iron condor needs two puts then two calls", ErrMismatchedSide)
+	case !(longPut.ID.Strike < shortPut.ID.Strike && shortPut.ID.Strike < shortCall.ID.Strike && shortCall.ID.Strike < longCall.ID.Strike):
+		return fmt.Errorf("%w: iron condor strikes must increase from long put to long call", ErrInvalidStrikes)
+	case isBuy(longPut.Instruction) == isBuy(shortPut.Instruction) || isBuy(shortCall.Instruction) == isBuy(longCall.Instruction):
+		return fmt.Errorf("%w: each side of the iron condor needs one buy and one sell", ErrInvalidInstructions)
+	default:
+		return nil
+	}
+}
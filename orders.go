@@ -0,0 +1,659 @@
+package td
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+	"time"
+
+	"github.com/google/go-querystring/query"
+)
+
+const (
+	orderTimeFmt      = "2006-01-02T15:04:05-0700"
+	orderQueryTimeFmt = "2006-01-02T15:04:05.000Z"
+)
+
+var (
+	ErrMissingOrderType   = errors.New("missing order type")
+	ErrMissingOrderID     = errors.New("missing order ID")
+	ErrMissingLegs        = errors.New("order must have at least one leg")
+	ErrMissingInstruction = errors.New("missing leg instruction")
+	ErrMissingAssetType   = errors.New("missing asset type")
+	ErrInvalidQuantity    = errors.New("quantity must be >0")
+	ErrMissingPrice       = errors.New("order type requires a price")
+	ErrMissingStopPrice   = errors.New("order type requires a stop price")
+	ErrMissingStopOffset  = errors.New("trailing orders require a stop price offset")
+	ErrMissingChildOrders = errors.New("order strategy requires child orders")
+	ErrMissingTimeRange   = errors.New("missing time range")
+	ErrInvalidTimeRange   = errors.New("start of time range must be before the end")
+	ErrOrdersTooOld       = errors.New("orders can only be listed from at most 60 days ago")
+	ErrMissingLocationID  = errors.New("response did not include an order ID in the location header")
+)
+
+//go:generate enumer -type OrderSession -trimprefix OrderSession -json -transform snake-upper
+type OrderSession byte
+
+const (
+	OrderSessionUnspecified OrderSession = iota
+	OrderSessionNormal
+	OrderSessionAM
+	OrderSessionPM
+	OrderSessionSeamless
+)
+
+//go:generate enumer -type OrderDuration -trimprefix OrderDuration -json -transform snake-upper
+type OrderDuration byte
+
+const (
+	OrderDurationUnspecified OrderDuration = iota
+	OrderDurationDay
+	OrderDurationGoodTillCancel
+	OrderDurationFillOrKill
+	OrderDurationImmediateOrCancel
+	OrderDurationEndOfWeek
+	OrderDurationEndOfMonth
+	OrderDurationNextEndOfMonth
+	OrderDurationUnknown
+)
+
+//go:generate enumer -type OrderType -trimprefix OrderType -json -transform snake-upper
+type OrderType byte
+
+const (
+	OrderTypeUnspecified OrderType = iota
+	OrderTypeMarket
+	OrderTypeLimit
+	OrderTypeStop
+	OrderTypeStopLimit
+	OrderTypeTrailingStop
+	OrderTypeCabinet
+	OrderTypeNonMarketable
+	OrderTypeMarketOnClose
+	OrderTypeExercise
+	OrderTypeTrailingStopLimit
+	OrderTypeNetDebit
+	OrderTypeNetCredit
+	OrderTypeNetZero
+	OrderTypeLimitOnClose
+	OrderTypeUnknown
+)
+
+func (o OrderType) needsPrice() bool {
+	switch o {
+	case OrderTypeLimit, OrderTypeStopLimit, OrderTypeNetDebit, OrderTypeNetCredit, OrderTypeLimitOnClose:
+		return true
+	default:
+		return false
+	}
+}
+
+//go:generate enumer -type ComplexOrderStrategyType -trimprefix ComplexOrderStrategyType -json -transform snake-upper
+type ComplexOrderStrategyType byte
+
+const (
+	ComplexOrderStrategyTypeUnspecified ComplexOrderStrategyType = iota
+	ComplexOrderStrategyTypeNone
+	ComplexOrderStrategyTypeCovered
+	ComplexOrderStrategyTypeVertical
+	ComplexOrderStrategyTypeBackRatio
+	ComplexOrderStrategyTypeCalendar
+	ComplexOrderStrategyTypeDiagonal
+	ComplexOrderStrategyTypeStraddle
+	ComplexOrderStrategyTypeStrangle
+	ComplexOrderStrategyTypeCollarSynthetic
+	ComplexOrderStrategyTypeButterfly
+	ComplexOrderStrategyTypeCondor
+	ComplexOrderStrategyTypeIronCondor
+	ComplexOrderStrategyTypeVerticalRoll
+	ComplexOrderStrategyTypeCollarWithStock
+	ComplexOrderStrategyTypeDoubleDiagonal
+	ComplexOrderStrategyTypeUnbalancedButterfly
+	ComplexOrderStrategyTypeUnbalancedCondor
+	ComplexOrderStrategyTypeUnbalancedIronCondor
+	ComplexOrderStrategyTypeUnbalancedVerticalRoll
+	ComplexOrderStrategyTypeMutualFundSwap
+	ComplexOrderStrategyTypeCustom
+)
+
+//go:generate enumer -type OrderStrategyType -trimprefix OrderStrategyType -json -transform snake-upper
+type OrderStrategyType byte
+
+const (
+	OrderStrategyTypeUnspecified OrderStrategyType = iota
+	OrderStrategyTypeSingle
+	OrderStrategyTypeCancel
+	OrderStrategyTypeRecall
+	OrderStrategyTypePair
+	OrderStrategyTypeFlatten
+	OrderStrategyTypeTwoDaySwap
+	OrderStrategyTypeBlastAll
+	OrderStrategyTypeOCO     // One cancels other: children are placed together, and when one fills the rest are canceled
+	OrderStrategyTypeTrigger // One triggers other: children are placed once this order fills
+)
+
+//go:generate enumer -type OrderStatus -trimprefix OrderStatus -json -transform snake-upper
+type OrderStatus byte
+
+const (
+	OrderStatusUnspecified OrderStatus = iota
+	OrderStatusAwaitingParentOrder
+	OrderStatusAwaitingCondition
+	OrderStatusAwaitingStopCondition
+	OrderStatusAwaitingManualReview
+	OrderStatusAccepted
+	OrderStatusAwaitingUrOut
+	OrderStatusPendingActivation
+	OrderStatusQueued
+	OrderStatusWorking
+	OrderStatusRejected
+	OrderStatusPendingCancel
+	OrderStatusCanceled
+	OrderStatusPendingReplace
+	OrderStatusReplaced
+	OrderStatusFilled
+	OrderStatusExpired
+	OrderStatusNew
+	OrderStatusAwaitingReleaseTime
+	OrderStatusPendingAcknowledgement
+	OrderStatusPendingRecall
+	OrderStatusUnknown
+)
+
+//go:generate enumer -type Instruction -trimprefix Instruction -json -transform snake-upper
+type Instruction byte
+
+const (
+	InstructionUnspecified Instruction = iota
+	InstructionBuy
+	InstructionSell
+	InstructionBuyToCover
+	InstructionSellShort
+	InstructionBuyToOpen
+	InstructionBuyToClose
+	InstructionSellToOpen
+	InstructionSellToClose
+	InstructionExchange
+	InstructionSellShortExempt
+)
+
+//go:generate enumer -type PositionEffect -trimprefix PositionEffect -json -transform snake-upper
+type PositionEffect byte
+
+const (
+	PositionEffectUnspecified PositionEffect = iota
+	PositionEffectOpening
+	PositionEffectClosing
+	PositionEffectAutomatic
+	PositionEffectUnknown
+)
+
+//go:generate enumer -type QuantityType -trimprefix QuantityType -json -transform snake-upper
+type QuantityType byte
+
+const (
+	QuantityTypeUnspecified QuantityType = iota
+	QuantityTypeAllShares
+	QuantityTypeDollars
+	QuantityTypeShares
+)
+
+//go:generate enumer -type PriceLinkBasis -trimprefix PriceLinkBasis -json -transform snake-upper
+type PriceLinkBasis byte
+
+const (
+	PriceLinkBasisUnspecified PriceLinkBasis = iota
+	PriceLinkBasisManual
+	PriceLinkBasisBase
+	PriceLinkBasisTrigger
+	PriceLinkBasisLast
+	PriceLinkBasisBid
+	PriceLinkBasisAsk
+	PriceLinkBasisAskBid
+	PriceLinkBasisMark
+	PriceLinkBasisAverage
+)
+
+//go:generate enumer -type PriceLinkType -trimprefix PriceLinkType -json -transform snake-upper
+type PriceLinkType byte
+
+const (
+	PriceLinkTypeUnspecified PriceLinkType = iota
+	PriceLinkTypeValue
+	PriceLinkTypePercent
+	PriceLinkTypeTick
+)
+
+//go:generate enumer -type StopType -trimprefix StopType -json -transform snake-upper
+type StopType byte
+
+const (
+	StopTypeUnspecified StopType = iota
+	StopTypeStandard
+	StopTypeBid
+	StopTypeAsk
+	StopTypeLast
+	StopTypeMark
+)
+
+//go:generate enumer -type TaxLotMethod -trimprefix TaxLotMethod -json -transform snake-upper
+type TaxLotMethod byte
+
+const (
+	TaxLotMethodUnspecified TaxLotMethod = iota
+	TaxLotMethodFIFO
+	TaxLotMethodLIFO
+	TaxLotMethodHighCost
+	TaxLotMethodLowCost
+	TaxLotMethodAverageCost
+	TaxLotMethodSpecificLot
+	TaxLotMethodLossHarvester
+)
+
+//go:generate enumer -type SpecialInstruction -trimprefix SpecialInstruction -json -transform snake-upper
+type SpecialInstruction byte
+
+const (
+	SpecialInstructionUnspecified SpecialInstruction = iota
+	SpecialInstructionAllOrNone
+	SpecialInstructionDoNotReduce
+	SpecialInstructionAllOrNoneDoNotReduce
+)
+
+// Order is used both to place orders and to read them back. When placing one,
+// only set what's needed: everything after ChildOrderStrategies is filled in by Schwab
+type Order struct {
+	Session                  OrderSession             `json:"session,omitzero"`
+	Duration                 OrderDuration            `json:"duration,omitzero"`
+	OrderType                OrderType                `json:"orderType,omitzero"`
+	ComplexOrderStrategyType ComplexOrderStrategyType `json:"complexOrderStrategyType,omitzero"`
+	OrderStrategyType        OrderStrategyType        `json:"orderStrategyType,omitzero"`
+	Quantity                 float64                  `json:"quantity,omitzero"`
+	Price                    float64                  `json:"price,omitzero"`
+	PriceLinkBasis           PriceLinkBasis           `json:"priceLinkBasis,omitzero"`
+	PriceLinkType            PriceLinkType            `json:"priceLinkType,omitzero"`
+	StopPrice                float64                  `json:"stopPrice,omitzero"`
+	StopPriceLinkBasis       PriceLinkBasis           `json:"stopPriceLinkBasis,omitzero"`
+	StopPriceLinkType        PriceLinkType            `json:"stopPriceLinkType,omitzero"`
+	StopPriceOffset          float64                  `json:"stopPriceOffset,omitzero"`
+	StopType                 StopType                 `json:"stopType,omitzero"`
+	ActivationPrice          float64                  `json:"activationPrice,omitzero"`
+	TaxLotMethod             TaxLotMethod             `json:"taxLotMethod,omitzero"`
+	SpecialInstruction       SpecialInstruction       `json:"specialInstruction,omitzero"`
+	RequestedDestination     string                   `json:"requestedDestination,omitzero"`
+	DestinationLinkName      string                   `json:"destinationLinkName,omitzero"`
+	CancelTime               time.Time                `json:"-"`
+	ReleaseTime              time.Time                `json:"-"`
+	OrderLegCollection       []OrderLeg               `json:"orderLegCollection,omitzero"`
+	ChildOrderStrategies     []Order                  `json:"childOrderStrategies,omitzero"`
+
+	OrderID                  int64           `json:"orderId,omitzero"`
+	AccountNumber            int64           `json:"accountNumber,omitzero"`
+	Status                   OrderStatus     `json:"status,omitzero"`
+	StatusDescription        string          `json:"statusDescription,omitzero"`
+	FilledQuantity           float64         `json:"filledQuantity,omitzero"`
+	RemainingQuantity        float64         `json:"remainingQuantity,omitzero"`
+	Cancelable               bool            `json:"cancelable,omitzero"`
+	Editable                 bool            `json:"editable,omitzero"`
+	Tag                      string          `json:"tag,omitzero"`
+	EnteredTime              time.Time       `json:"-"`
+	CloseTime                time.Time       `json:"-"`
+	OrderActivityCollection  []OrderActivity `json:"orderActivityCollection,omitzero"`
+	ReplacingOrderCollection []Order         `json:"replacingOrderCollection,omitzero"`
+}
+
+type OrderLeg struct {
+	LegID          int64          `json:"legId,omitzero"`
+	OrderLegType   AssetType      `json:"orderLegType,omitzero"`
+	Instrument     Instrument     `json:"instrument"`
+	Instruction    Instruction    `json:"instruction"`
+	PositionEffect PositionEffect `json:"positionEffect,omitzero"`
+	Quantity       float64        `json:"quantity"`
+	QuantityType   QuantityType   `json:"quantityType,omitzero"`
+	DivCapGains    string         `json:"divCapGains,omitzero"` // REINVEST or PAYOUT, mutual funds only
+	ToSymbol       string         `json:"toSymbol,omitzero"`
+}
+
+type OrderActivity struct {
+	ActivityType           string         `json:"activityType"`  // EXECUTION or ORDER_ACTION
+	ExecutionType          string         `json:"executionType"` // FILL
+	Quantity               float64        `json:"quantity"`
+	OrderRemainingQuantity float64        `json:"orderRemainingQuantity"`
+	ExecutionLegs          []ExecutionLeg `json:"executionLegs"`
+}
+
+type ExecutionLeg struct {
+	LegID             int64     `json:"legId"`
+	InstrumentID      int64     `json:"instrumentId"`
+	Price             float64   `json:"price"`
+	Quantity          float64   `json:"quantity"`
+	MismarkedQuantity float64   `json:"mismarkedQuantity"`
+	Time              time.Time `json:"-"`
+}
+
+// orderTime is the timestamp format the trader API uses for orders and
+// transactions, which isn't quite RFC3339
+type orderTime time.Time
+
+func (o orderTime) IsZero() bool { return time.Time(o).IsZero() }
+
+func (o orderTime) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Time(o).Format(orderTimeFmt))
+}
+
+func (o *orderTime) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+
+	if s == "" {
+		*o = orderTime{}
+		return nil
+	}
+
+	t, err := time.Parse(orderTimeFmt, s)
+	if err != nil {
+		if t, err = time.Parse(time.RFC3339, s); err != nil {
+			return err
+		}
+	}
+
+	*o = orderTime(t)
+	return nil
+}
+
+type orderWrapper struct {
+	CancelTime  orderTime `json:"cancelTime,omitzero"`
+	ReleaseTime orderTime `json:"releaseTime,omitzero"`
+	EnteredTime orderTime `json:"enteredTime,omitzero"`
+	CloseTime   orderTime `json:"closeTime,omitzero"`
+}
+
+func (o Order) MarshalJSON() ([]byte, error) {
+	type order Order
+	return json.Marshal(struct {
+		order
+		orderWrapper
+	}{
+		order: order(o),
+		orderWrapper: orderWrapper{
+			CancelTime:  orderTime(o.CancelTime),
+			ReleaseTime: orderTime(o.ReleaseTime),
+			EnteredTime: orderTime(o.EnteredTime),
+			CloseTime:   orderTime(o.CloseTime),
+		},
+	})
+}
+
+func (o *Order) UnmarshalJSON(b []byte) error {
+	type order Order
+	x := struct {
+		*order
+		orderWrapper
+	}{order: (*order)(o)}
+
+	if err := json.Unmarshal(b, &x); err != nil {
+		return err
+	}
+
+	o.CancelTime = time.Time(x.orderWrapper.CancelTime)
+	o.ReleaseTime = time.Time(x.orderWrapper.ReleaseTime)
+	o.EnteredTime = time.Time(x.orderWrapper.EnteredTime)
+	o.CloseTime = time.Time(x.orderWrapper.CloseTime)
+	return nil
+}
+
+func (e *ExecutionLeg) UnmarshalJSON(b []byte) error {
+	type executionLeg ExecutionLeg
+	x := struct {
+		*executionLeg
+		Time orderTime `json:"time"`
+	}{executionLeg: (*executionLeg)(e)}
+
+	if err := json.Unmarshal(b, &x); err != nil {
+		return err
+	}
+
+	e.Time = time.Time(x.Time)
+	return nil
+}
+
+// Validate checks the order has what Schwab needs to accept it, recursing
+// into child orders. It doesn't catch everything Schwab will reject,
+// like prices off the tick size, but does catch what's obviously missing
+func (o *Order) Validate() error {
+	switch o.OrderStrategyType {
+	case OrderStrategyTypeOCO:
+		// the parent is only a container for its children
+		if len(o.ChildOrderStrategies) < 2 {
+			return fmt.Errorf("%w: OCO needs at least 2, got %d", ErrMissingChildOrders, len(o.ChildOrderStrategies))
+		}
+
+		return o.validateChildren()
+	case OrderStrategyTypeTrigger:
+		if len(o.ChildOrderStrategies) == 0 {
+			return fmt.Errorf("%w: trigger needs at least 1", ErrMissingChildOrders)
+		}
+	}
+
+	switch {
+	case o.OrderType == OrderTypeUnspecified:
+		return ErrMissingOrderType
+	case len(o.OrderLegCollection) == 0:
+		return ErrMissingLegs
+	case o.OrderType.needsPrice() && o.Price <= 0:
+		return fmt.Errorf("%w: %s", ErrMissingPrice, o.OrderType)
+	case (o.OrderType == OrderTypeStop || o.OrderType == OrderTypeStopLimit) && o.StopPrice <= 0:
+		return fmt.Errorf("%w: %s", ErrMissingStopPrice, o.OrderType)
+	case (o.OrderType == OrderTypeTrailingStop || o.OrderType == OrderTypeTrailingStopLimit) && o.StopPriceOffset <= 0:
+		return ErrMissingStopOffset
+	}
+
+	for i := range o.OrderLegCollection {
+		if err := o.OrderLegCollection[i].validate(); err != nil {
+			return fmt.Errorf("leg %d: %w", i, err)
+		}
+	}
+
+	return o.validateChildren()
+}
+
+func (o *Order) validateChildren() error {
+	for i := range o.ChildOrderStrategies {
+		if err := o.ChildOrderStrategies[i].Validate(); err != nil {
+			return fmt.Errorf("child order %d: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+func (l *OrderLeg) validate() error {
+	switch {
+	case l.Instrument.Symbol == "":
+		return ErrMissingSymbol
+	case l.Instrument.AssetType == AssetTypeUnspecified:
+		return ErrMissingAssetType
+	case l.Instruction == InstructionUnspecified:
+		return ErrMissingInstruction
+	case l.Quantity <= 0:
+		return ErrInvalidQuantity
+	default:
+		return nil
+	}
+}
+
+// How far back ListOrders can go: Schwab rejects a From more than 60 days ago
+const OrdersLookback = 60 * 24 * time.Hour
+
+// OrdersReq filters ListOrders. Schwab requires both ends of the time range,
+// and From can be at most OrdersLookback ago
+type OrdersReq struct {
+	From, To   time.Time // Time the order was entered
+	MaxResults int       // Defaults to 3000 when unset
+	Status     OrderStatus
+}
+
+func (r *OrdersReq) validate() error {
+	switch {
+	case r.From.IsZero() || r.To.IsZero():
+		return ErrMissingTimeRange
+	case !r.From.Before(r.To):
+		return ErrInvalidTimeRange
+	case time.Since(r.From) > OrdersLookback:
+		return fmt.Errorf("%w: from is %s", ErrOrdersTooOld, r.From.Format(time.DateOnly))
+	default:
+		return nil
+	}
+}
+
+func (r *OrdersReq) Encode() (string, error) {
+	req := struct {
+		FromEnteredTime string      `url:"fromEnteredTime"`
+		ToEnteredTime   string      `url:"toEnteredTime"`
+		MaxResults      int         `url:"maxResults,omitempty"`
+		Status          OrderStatus `url:"status,omitempty"`
+	}{
+		FromEnteredTime: r.From.UTC().Format(orderQueryTimeFmt),
+		ToEnteredTime:   r.To.UTC().Format(orderQueryTimeFmt),
+		MaxResults:      r.MaxResults,
+		Status:          r.Status,
+	}
+
+	q, err := query.Values(req)
+	if err != nil {
+		return "", err
+	}
+
+	return q.Encode(), nil
+}
+
+func ordersPath(hash string) string {
+	return fmt.Sprintf("/accounts/%s/orders", url.PathEscape(hash))
+}
+
+// orderIDFromLocation pulls the order ID off the end of the Location
+// header Schwab sends back when an order is placed or replaced
+func orderIDFromLocation(h http.Header) (int64, error) {
+	loc := h.Get("Location")
+	if loc == "" {
+		return 0, ErrMissingLocationID
+	}
+
+	id, err := strconv.ParseInt(path.Base(loc), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %s", ErrMissingLocationID, loc)
+	}
+
+	return id, nil
+}
+
+// PlaceOrder places the order on the account with the hash given and returns its ID.
+// The order is validated before sending
+func (c *HTTPClient) PlaceOrder(ctx context.Context, hash string, o *Order) (int64, error) {
+	switch {
+	case hash == "":
+		return 0, ErrMissingAcctHash
+	case o == nil:
+		return 0, ErrMissingReq
+	}
+
+	if err := o.Validate(); err != nil {
+		c.logger.ErrorContext(ctx, "invalid order", "err", err)
+		return 0, err
+	}
+
+	h, err := c.doHeader(ctx, http.MethodPost, ordersPath(hash), o, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	return orderIDFromLocation(h)
+}
+
+// ReplaceOrder cancels the order with the ID given and places o in its place.
+// The returned ID is the ID of the new order
+func (c *HTTPClient) ReplaceOrder(ctx context.Context, hash string, orderID int64, o *Order) (int64, error) {
+	switch {
+	case hash == "":
+		return 0, ErrMissingAcctHash
+	case orderID == 0:
+		return 0, ErrMissingOrderID
+	case o == nil:
+		return 0, ErrMissingReq
+	}
+
+	if err := o.Validate(); err != nil {
+		c.logger.ErrorContext(ctx, "invalid order", "err", err)
+		return 0, err
+	}
+
+	h, err := c.doHeader(ctx, http.MethodPut, fmt.Sprintf("%s/%d", ordersPath(hash), orderID), o, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	return orderIDFromLocation(h)
+}
+
+func (c *HTTPClient) CancelOrder(ctx context.Context, hash string, orderID int64) error {
+	switch {
+	case hash == "":
+		return ErrMissingAcctHash
+	case orderID == 0:
+		return ErrMissingOrderID
+	}
+
+	return c.do(ctx, http.MethodDelete, fmt.Sprintf("%s/%d", ordersPath(hash), orderID), nil, nil)
+}
+
+func (c *HTTPClient) GetOrder(ctx context.Context, hash string, orderID int64) (*Order, error) {
+	switch {
+	case hash == "":
+		return nil, ErrMissingAcctHash
+	case orderID == 0:
+		return nil, ErrMissingOrderID
+	}
+
+	o := new(Order)
+	if err := c.do(ctx, http.MethodGet, fmt.Sprintf("%s/%d", ordersPath(hash), orderID), nil, o); err != nil {
+		return nil, err
+	}
+
+	return o, nil
+}
+
+// ListOrders lists orders on the account with the hash given. Leave the
+// hash empty to list orders across every account
+func (c *HTTPClient) ListOrders(ctx context.Context, hash string, req *OrdersReq) ([]Order, error) {
+	if req == nil {
+		return nil, ErrMissingReq
+	}
+
+	if err := req.validate(); err != nil {
+		return nil, err
+	}
+
+	encode, err := req.Encode()
+	if err != nil {
+		return nil, err
+	}
+
+	p := "/orders"
+	if hash != "" {
+		p = ordersPath(hash)
+	}
+
+	var o []Order
+	if err = c.do(ctx, http.MethodGet, fmt.Sprintf("%s?%s", p, encode), nil, &o); err != nil {
+		return nil, err
+	}
+
+	return o, nil
+}
@@ -0,0 +1,197 @@
+package td
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func equityLeg(symbol string, i Instruction, qty float64) OrderLeg {
+	return OrderLeg{
+		Instrument:  Instrument{Symbol: symbol, AssetType: AssetTypeEquity},
+		Instruction: i,
+		Quantity:    qty,
+	}
+}
+
+func TestOrderValidate(mainTest *testing.T) {
+	limit := Order{
+		OrderType:          OrderTypeLimit,
+		Price:              100,
+		OrderLegCollection: []OrderLeg{equityLeg("AAPL", InstructionBuy, 1)},
+	}
+
+	testCases := []struct {
+		name     string
+		arg      Order
+		expected error
+	}{
+		{"valid", limit, nil},
+		{"missing order type", Order{OrderLegCollection: limit.OrderLegCollection}, ErrMissingOrderType},
+		{"missing legs", Order{OrderType: OrderTypeMarket}, ErrMissingLegs},
+		{"limit without price", Order{OrderType: OrderTypeLimit, OrderLegCollection: limit.OrderLegCollection}, ErrMissingPrice},
+		{"stop without stop price", Order{OrderType: OrderTypeStop, OrderLegCollection: limit.OrderLegCollection}, ErrMissingStopPrice},
+		{"trailing without offset", Order{OrderType: OrderTypeTrailingStop, OrderLegCollection: limit.OrderLegCollection}, ErrMissingStopOffset},
+		{"leg missing symbol", Order{OrderType: OrderTypeMarket, OrderLegCollection: []OrderLeg{equityLeg("", InstructionBuy, 1)}}, ErrMissingSymbol},
+		{"leg missing instruction", Order{OrderType: OrderTypeMarket, OrderLegCollection: []OrderLeg{equityLeg("AAPL", InstructionUnspecified, 1)}}, ErrMissingInstruction},
+		{"leg missing quantity", Order{OrderType: OrderTypeMarket, OrderLegCollection: []OrderLeg{equityLeg("AAPL", InstructionBuy, 0)}}, ErrInvalidQuantity},
+		{"oco with one child", Order{OrderStrategyType: OrderStrategyTypeOCO, ChildOrderStrategies: []Order{limit}}, ErrMissingChildOrders},
+		{"oco with invalid child", Order{OrderStrategyType: OrderStrategyTypeOCO, ChildOrderStrategies: []Order{limit, {}}}, ErrMissingOrderType},
+		{"valid oco", Order{OrderStrategyType: OrderStrategyTypeOCO, ChildOrderStrategies: []Order{limit, limit}}, nil},
+		{"trigger without children", Order{OrderStrategyType: OrderStrategyTypeTrigger, OrderType: OrderTypeMarket, OrderLegCollection: limit.OrderLegCollection}, ErrMissingChildOrders},
+	}
+
+	for _, tc := range testCases {
+		mainTest.Run(tc.name, func(tt *testing.T) {
+			err := tc.arg.Validate()
+			if tc.expected == nil {
+				if err != nil {
+					tt.Errorf("should not fail, got %s", err)
+				}
+				return
+			}
+
+			if !errors.Is(err, tc.expected) {
+				tt.Errorf("want %s, got %v", tc.expected, err)
+			}
+		})
+	}
+}
+
+func TestOrdersReqValidate(mainTest *testing.T) {
+	now := time.Now()
+
+	testCases := []struct {
+		name     string
+		arg      OrdersReq
+		expected error
+	}{
+		{"valid", OrdersReq{From: now.AddDate(0, 0, -30), To: now}, nil},
+		{"missing from", OrdersReq{To: now}, ErrMissingTimeRange},
+		{"backwards", OrdersReq{From: now, To: now.AddDate(0, 0, -1)}, ErrInvalidTimeRange},
+		{"past the lookback", OrdersReq{From: now.Add(-OrdersLookback - time.Hour), To: now}, ErrOrdersTooOld},
+	}
+
+	for _, tc := range testCases {
+		mainTest.Run(tc.name, func(tt *testing.T) {
+			err := tc.arg.validate()
+			if tc.expected == nil {
+				if err != nil {
+					tt.Errorf("should not fail, got %s", err)
+				}
+				return
+			}
+
+			if !errors.Is(err, tc.expected) {
+				tt.Errorf("want %s, got %v", tc.expected, err)
+			}
+		})
+	}
+}
+
+func TestOrderJSON(t *testing.T) {
+	entered := time.Date(2024, 3, 18, 14, 0, 0, 0, time.UTC)
+	arg := `{"session":"NORMAL","duration":"DAY","orderType":"LIMIT","quantity":10,"filledQuantity":10,"remainingQuantity":0,"price":150.25,
+"orderLegCollection":[{"orderLegType":"EQUITY","legId":1,"instrument":{"assetType":"EQUITY","cusip":"037833100","symbol":"AAPL","instrumentId":1973757747},"instruction":"BUY","positionEffect":"OPENING","quantity":10}],
+"orderStrategyType":"SINGLE","orderId":1000,"cancelable":false,"editable":false,"status":"FILLED","enteredTime":"2024-03-18T14:00:00+0000","closeTime":"2024-03-18T14:00:01+0000","accountNumber":12345678,
+"orderActivityCollection":[{"activityType":"EXECUTION","executionType":"FILL","quantity":10,"orderRemainingQuantity":0,"executionLegs":[{"legId":1,"quantity":10,"mismarkedQuantity":0,"price":150.25,"time":"2024-03-18T14:00:01+0000","instrumentId":1973757747}]}]}`
+
+	want := Order{
+		Session:           OrderSessionNormal,
+		Duration:          OrderDurationDay,
+		OrderType:         OrderTypeLimit,
+		Quantity:          10,
+		FilledQuantity:    10,
+		Price:             150.25,
+		OrderStrategyType: OrderStrategyTypeSingle,
+		OrderID:           1000,
+		Status:            OrderStatusFilled,
+		EnteredTime:       entered,
+		CloseTime:         entered.Add(time.Second),
+		AccountNumber:     12345678,
+		OrderLegCollection: []OrderLeg{{
+			OrderLegType:   AssetTypeEquity,
+			LegID:          1,
+			Instrument:     Instrument{AssetType: AssetTypeEquity, Cusip: "037833100", Symbol: "AAPL", InstrumentID: 1973757747},
+			Instruction:    InstructionBuy,
+			PositionEffect: PositionEffectOpening,
+			Quantity:       10,
+		}},
+		OrderActivityCollection: []OrderActivity{{
+			ActivityType:  "EXECUTION",
+			ExecutionType: "FILL",
+			Quantity:      10,
+			ExecutionLegs: []ExecutionLeg{{LegID: 1, Quantity: 10, Price: 150.25, Time: entered.Add(time.Second), InstrumentID: 1973757747}},
+		}},
+	}
+
+	var got Order
+	if err := json.Unmarshal([]byte(arg), &got); err != nil {
+		t.Fatalf("should not fail unmarshal: %s", err)
+	}
+
+	// normalize locations so DeepEqual compares instants
+	got.EnteredTime, got.CloseTime = got.EnteredTime.UTC(), got.CloseTime.UTC()
+	got.OrderActivityCollection[0].ExecutionLegs[0].Time = got.OrderActivityCollection[0].ExecutionLegs[0].Time.UTC()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("want %+v\ngot  %+v", want, got)
+	}
+
+	buf, err := json.Marshal(Order{
+		OrderType:          OrderTypeMarket,
+		Session:            OrderSessionNormal,
+		Duration:           OrderDurationDay,
+		OrderStrategyType:  OrderStrategyTypeSingle,
+		OrderLegCollection: []OrderLeg{equityLeg("AAPL", InstructionBuy, 1)},
+	})
+	if err != nil {
+		t.Fatalf("should not fail marshal: %s", err)
+	}
+
+	wantBody := `{"session":"NORMAL","duration":"DAY","orderType":"MARKET","orderStrategyType":"SINGLE","orderLegCollection":[{"instrument":{"assetType":"EQUITY","symbol":"AAPL"},"instruction":"BUY","quantity":1}]}`
+	if string(buf) != wantBody {
+		t.Errorf("unset fields should be left out of the request\nwant %s\ngot  %s", wantBody, buf)
+	}
+}
+
+func TestPlaceOrder(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/accounts/ABCDEF/orders" {
+			t.Errorf("want POST to /accounts/ABCDEF/orders, got %s %s", r.Method, r.URL.Path)
+		}
+
+		b, _ := io.ReadAll(r.Body)
+		var o Order
+		if err := json.Unmarshal(b, &o); err != nil || o.OrderType != OrderTypeMarket {
+			t.Errorf("order should be sent in the body, got %s (%v)", b, err)
+		}
+
+		w.Header().Set("Location", "https://api.schwabapi.com/trader/v1/accounts/ABCDEF/orders/1000")
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer srv.Close()
+
+	c := &HTTPClient{baseURL: srv.URL, http: srv.Client(), logger: slog.New(slog.DiscardHandler)}
+	o := &Order{OrderType: OrderTypeMarket, OrderLegCollection: []OrderLeg{equityLeg("AAPL", InstructionBuy, 1)}}
+
+	if _, err := c.PlaceOrder(context.Background(), "ABCDEF", &Order{}); !errors.Is(err, ErrMissingOrderType) {
+		t.Errorf("invalid orders should not be sent, got %v", err)
+	}
+
+	id, err := c.PlaceOrder(context.Background(), "ABCDEF", o)
+	if err != nil {
+		t.Fatalf("should not fail, got %s", err)
+	}
+
+	if id != 1000 {
+		t.Errorf("order ID should come from the location header, got %d", id)
+	}
+}
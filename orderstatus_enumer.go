@@ -0,0 +1,176 @@
+// Code generated by "enumer -type OrderStatus -trimprefix OrderStatus -json -transform snake-upper"; DO NOT EDIT.
+
+package td
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+const _OrderStatusName = "UNSPECIFIEDAWAITING_PARENT_ORDERAWAITING_CONDITIONAWAITING_STOP_CONDITIONAWAITING_MANUAL_REVIEWACCEPTEDAWAITING_UR_OUTPENDING_ACTIVATIONQUEUEDWORKINGREJECTEDPENDING_CANCELCANCELEDPENDING_REPLACEREPLACEDFILLEDEXPIREDNEWAWAITING_RELEASE_TIMEPENDING_ACKNOWLEDGEMENTPENDING_RECALLUNKNOWN"
+
+var _OrderStatusIndex = [...]uint16{0, 11, 32, 50, 73, 95, 103, 118, 136, 142, 149, 157, 171, 179, 194, 202, 208, 215, 218, 239, 262, 276, 283}
+
+const _OrderStatusLowerName = "unspecifiedawaiting_parent_orderawaiting_conditionawaiting_stop_conditionawaiting_manual_reviewacceptedawaiting_ur_outpending_activationqueuedworkingrejectedpending_cancelcanceledpending_replacereplacedfilledexpirednewawaiting_release_timepending_acknowledgementpending_recallunknown"
+
+func (i OrderStatus) String() string {
+	if i >= OrderStatus(len(_OrderStatusIndex)-1) {
+		return fmt.Sprintf("OrderStatus(%d)", i)
+	}
+	return _OrderStatusName[_OrderStatusIndex[i]:_OrderStatusIndex[i+1]]
+}
+
+// An "invalid array index" compiler error signifies that the constant values have changed.
+// Re-run the stringer command to generate them again.
+func _OrderStatusNoOp() {
+	var x [1]struct{}
+	_ = x[OrderStatusUnspecified-(0)]
+	_ = x[OrderStatusAwaitingParentOrder-(1)]
+	_ = x[OrderStatusAwaitingCondition-(2)]
+	_ = x[OrderStatusAwaitingStopCondition-(3)]
+	_ = x[OrderStatusAwaitingManualReview-(4)]
+	_ = x[OrderStatusAccepted-(5)]
+	_ = x[OrderStatusAwaitingUrOut-(6)]
+	_ = x[OrderStatusPendingActivation-(7)]
+	_ = x[OrderStatusQueued-(8)]
+	_ = x[OrderStatusWorking-(9)]
+	_ = x[OrderStatusRejected-(10)]
+	_ = x[OrderStatusPendingCancel-(11)]
+	_ = x[OrderStatusCanceled-(12)]
+	_ = x[OrderStatusPendingReplace-(13)]
+	_ = x[OrderStatusReplaced-(14)]
+	_ = x[OrderStatusFilled-(15)]
+	_ = x[OrderStatusExpired-(16)]
+	_ = x[OrderStatusNew-(17)]
+	_ = x[OrderStatusAwaitingReleaseTime-(18)]
+	_ = x[OrderStatusPendingAcknowledgement-(19)]
+	_ = x[OrderStatusPendingRecall-(20)]
+	_ = x[OrderStatusUnknown-(21)]
+}
+
+var _OrderStatusValues = []OrderStatus{OrderStatusUnspecified, OrderStatusAwaitingParentOrder, OrderStatusAwaitingCondition, OrderStatusAwaitingStopCondition, OrderStatusAwaitingManualReview, OrderStatusAccepted, OrderStatusAwaitingUrOut, OrderStatusPendingActivation, OrderStatusQueued, OrderStatusWorking, OrderStatusRejected, OrderStatusPendingCancel, OrderStatusCanceled, OrderStatusPendingReplace, OrderStatusReplaced, OrderStatusFilled, OrderStatusExpired, OrderStatusNew, OrderStatusAwaitingReleaseTime, OrderStatusPendingAcknowledgement, OrderStatusPendingRecall, OrderStatusUnknown}
+
+var _OrderStatusNameToValueMap = map[string]OrderStatus{
+	_OrderStatusName[0:11]:         OrderStatusUnspecified,
+	_OrderStatusLowerName[0:11]:    OrderStatusUnspecified,
+	_OrderStatusName[11:32]:        OrderStatusAwaitingParentOrder,
+	_OrderStatusLowerName[11:32]:   OrderStatusAwaitingParentOrder,
+	_OrderStatusName[32:50]:        OrderStatusAwaitingCondition,
+	_OrderStatusLowerName[32:50]:   OrderStatusAwaitingCondition,
+	_OrderStatusName[50:73]:        OrderStatusAwaitingStopCondition,
+	_OrderStatusLowerName[50:73]:   OrderStatusAwaitingStopCondition,
+	_OrderStatusName[73:95]:        OrderStatusAwaitingManualReview,
+	_OrderStatusLowerName[73:95]:   OrderStatusAwaitingManualReview,
+	_OrderStatusName[95:103]:       OrderStatusAccepted,
+	_OrderStatusLowerName[95:103]:  OrderStatusAccepted,
+	_OrderStatusName[103:118]:      OrderStatusAwaitingUrOut,
+	_OrderStatusLowerName[103:118]: OrderStatusAwaitingUrOut,
+	_OrderStatusName[118:136]:      OrderStatusPendingActivation,
+	_OrderStatusLowerName[118:136]: OrderStatusPendingActivation,
+	_OrderStatusName[136:142]:      OrderStatusQueued,
+	_OrderStatusLowerName[136:142]: OrderStatusQueued,
+	_OrderStatusName[142:149]:      OrderStatusWorking,
+	_OrderStatusLowerName[142:149]: OrderStatusWorking,
+	_OrderStatusName[149:157]:      OrderStatusRejected,
+	_OrderStatusLowerName[149:157]: OrderStatusRejected,
+	_OrderStatusName[157:171]:      OrderStatusPendingCancel,
+	_OrderStatusLowerName[157:171]: OrderStatusPendingCancel,
+	_OrderStatusName[171:179]:      OrderStatusCanceled,
+	_OrderStatusLowerName[171:179]: OrderStatusCanceled,
+	_OrderStatusName[179:194]:      OrderStatusPendingReplace,
+	_OrderStatusLowerName[179:194]: OrderStatusPendingReplace,
+	_OrderStatusName[194:202]:      OrderStatusReplaced,
+	_OrderStatusLowerName[194:202]: OrderStatusReplaced,
+	_OrderStatusName[202:208]:      OrderStatusFilled,
+	_OrderStatusLowerName[202:208]: OrderStatusFilled,
+	_OrderStatusName[208:215]:      OrderStatusExpired,
+	_OrderStatusLowerName[208:215]: OrderStatusExpired,
+	_OrderStatusName[215:218]:      OrderStatusNew,
+	_OrderStatusLowerName[215:218]: OrderStatusNew,
+	_OrderStatusName[218:239]:      OrderStatusAwaitingReleaseTime,
+	_OrderStatusLowerName[218:239]: OrderStatusAwaitingReleaseTime,
+	_OrderStatusName[239:262]:      OrderStatusPendingAcknowledgement,
+	_OrderStatusLowerName[239:262]: OrderStatusPendingAcknowledgement,
+	_OrderStatusName[262:276]:      OrderStatusPendingRecall,
+	_OrderStatusLowerName[262:276]: OrderStatusPendingRecall,
+	_OrderStatusName[276:283]:      OrderStatusUnknown,
+	_OrderStatusLowerName[276:283]: OrderStatusUnknown,
+}
+
+var _OrderStatusNames = []string{
+	_OrderStatusName[0:11],
+	_OrderStatusName[11:32],
+	_OrderStatusName[32:50],
+	_OrderStatusName[50:73],
+	_OrderStatusName[73:95],
+	_OrderStatusName[95:103],
+	_OrderStatusName[103:118],
+	_OrderStatusName[118:136],
+	_OrderStatusName[136:142],
+	_OrderStatusName[142:149],
+	_OrderStatusName[149:157],
+	_OrderStatusName[157:171],
+	_OrderStatusName[171:179],
+	_OrderStatusName[179:194],
+	_OrderStatusName[194:202],
+	_OrderStatusName[202:208],
+	_OrderStatusName[208:215],
+	_OrderStatusName[215:218],
+	_OrderStatusName[218:239],
+	_OrderStatusName[239:262],
+	_OrderStatusName[262:276],
+	_OrderStatusName[276:283],
+}
+
+// OrderStatusString retrieves an enum value from the enum constants string name.
+// Throws an error if the param is not part of the enum.
+func OrderStatusString(s string) (OrderStatus, error) {
+	if val, ok := _OrderStatusNameToValueMap[s]; ok {
+		return val, nil
+	}
+
+	if val, ok := _OrderStatusNameToValueMap[strings.ToLower(s)]; ok {
+		return val, nil
+	}
+	return 0, fmt.Errorf("%s does not belong to OrderStatus values", s)
+}
+
+// OrderStatusValues returns all values of the enum
+func OrderStatusValues() []OrderStatus {
+	return _OrderStatusValues
+}
+
+// OrderStatusStrings returns a slice of all String values of the enum
+func OrderStatusStrings() []string {
+	strs := make([]string, len(_OrderStatusNames))
+	copy(strs, _OrderStatusNames)
+	return strs
+}
+
+// IsAOrderStatus returns "true" if the value is listed in the enum definition. "false" otherwise
+func (i OrderStatus) IsAOrderStatus() bool {
+	for _, v := range _OrderStatusValues {
+		if i == v {
+			return true
+		}
+	}
+	return false
+}
+
+// MarshalJSON implements the json.Marshaler interface for OrderStatus
+func (i OrderStatus) MarshalJSON() ([]byte, error) {
+	return json.Marshal(i.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for OrderStatus
+func (i *OrderStatus) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("OrderStatus should be a string, got %s", data)
+	}
+
+	var err error
+	*i, err = OrderStatusString(s)
+	return err
+}
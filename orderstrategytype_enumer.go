@@ -0,0 +1,128 @@
+// Code generated by "enumer -type OrderStrategyType -trimprefix OrderStrategyType -json -transform snake-upper"; DO NOT EDIT.
+
+package td
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+const _OrderStrategyTypeName = "UNSPECIFIEDSINGLECANCELRECALLPAIRFLATTENTWO_DAY_SWAPBLAST_ALLOCOTRIGGER"
+
+var _OrderStrategyTypeIndex = [...]uint8{0, 11, 17, 23, 29, 33, 40, 52, 61, 64, 71}
+
+const _OrderStrategyTypeLowerName = "unspecifiedsinglecancelrecallpairflattentwo_day_swapblast_allocotrigger"
+
+func (i OrderStrategyType) String() string {
+	if i >= OrderStrategyType(len(_OrderStrategyTypeIndex)-1) {
+		return fmt.Sprintf("OrderStrategyType(%d)", i)
+	}
+	return _OrderStrategyTypeName[_OrderStrategyTypeIndex[i]:_OrderStrategyTypeIndex[i+1]]
+}
+
+// An "invalid array index" compiler error signifies that the constant values have changed.
+// Re-run the stringer command to generate them again.
+func _OrderStrategyTypeNoOp() {
+	var x [1]struct{}
+	_ = x[OrderStrategyTypeUnspecified-(0)]
+	_ = x[OrderStrategyTypeSingle-(1)]
+	_ = x[OrderStrategyTypeCancel-(2)]
+	_ = x[OrderStrategyTypeRecall-(3)]
+	_ = x[OrderStrategyTypePair-(4)]
+	_ = x[OrderStrategyTypeFlatten-(5)]
+	_ = x[OrderStrategyTypeTwoDaySwap-(6)]
+	_ = x[OrderStrategyTypeBlastAll-(7)]
+	_ = x[OrderStrategyTypeOCO-(8)]
+	_ = x[OrderStrategyTypeTrigger-(9)]
+}
+
+var _OrderStrategyTypeValues = []OrderStrategyType{OrderStrategyTypeUnspecified, OrderStrategyTypeSingle, OrderStrategyTypeCancel, OrderStrategyTypeRecall, OrderStrategyTypePair, OrderStrategyTypeFlatten, OrderStrategyTypeTwoDaySwap, OrderStrategyTypeBlastAll, OrderStrategyTypeOCO, OrderStrategyTypeTrigger}
+
+var _OrderStrategyTypeNameToValueMap = map[string]OrderStrategyType{
+	_OrderStrategyTypeName[0:11]:       OrderStrategyTypeUnspecified,
+	_OrderStrategyTypeLowerName[0:11]:  OrderStrategyTypeUnspecified,
+	_OrderStrategyTypeName[11:17]:      OrderStrategyTypeSingle,
+	_OrderStrategyTypeLowerName[11:17]: OrderStrategyTypeSingle,
+	_OrderStrategyTypeName[17:23]:      OrderStrategyTypeCancel,
+	_OrderStrategyTypeLowerName[17:23]: OrderStrategyTypeCancel,
+	_OrderStrategyTypeName[23:29]:      OrderStrategyTypeRecall,
+	_OrderStrategyTypeLowerName[23:29]: OrderStrategyTypeRecall,
+	_OrderStrategyTypeName[29:33]:      OrderStrategyTypePair,
+	_OrderStrategyTypeLowerName[29:33]: OrderStrategyTypePair,
+	_OrderStrategyTypeName[33:40]:      OrderStrategyTypeFlatten,
+	_OrderStrategyTypeLowerName[33:40]: OrderStrategyTypeFlatten,
+	_OrderStrategyTypeName[40:52]:      OrderStrategyTypeTwoDaySwap,
+	_OrderStrategyTypeLowerName[40:52]: OrderStrategyTypeTwoDaySwap,
+	_OrderStrategyTypeName[52:61]:      OrderStrategyTypeBlastAll,
+	_OrderStrategyTypeLowerName[52:61]: OrderStrategyTypeBlastAll,
+	_OrderStrategyTypeName[61:64]:      OrderStrategyTypeOCO,
+	_OrderStrategyTypeLowerName[61:64]: OrderStrategyTypeOCO,
+	_OrderStrategyTypeName[64:71]:      OrderStrategyTypeTrigger,
+	_OrderStrategyTypeLowerName[64:71]: OrderStrategyTypeTrigger,
+}
+
+var _OrderStrategyTypeNames = []string{
+	_OrderStrategyTypeName[0:11],
+	_OrderStrategyTypeName[11:17],
+	_OrderStrategyTypeName[17:23],
+	_OrderStrategyTypeName[23:29],
+	_OrderStrategyTypeName[29:33],
+	_OrderStrategyTypeName[33:40],
+	_OrderStrategyTypeName[40:52],
+	_OrderStrategyTypeName[52:61],
+	_OrderStrategyTypeName[61:64],
+	_OrderStrategyTypeName[64:71],
+}
+
+// OrderStrategyTypeString retrieves an enum value from the enum constants string name.
+// Throws an error if the param is not part of the enum.
+func OrderStrategyTypeString(s string) (OrderStrategyType, error) {
+	if val, ok := _OrderStrategyTypeNameToValueMap[s]; ok {
+		return val, nil
+	}
+
+	if val, ok := _OrderStrategyTypeNameToValueMap[strings.ToLower(s)]; ok {
+		return val, nil
+	}
+	return 0, fmt.Errorf("%s does not belong to OrderStrategyType values", s)
+}
+
+// OrderStrategyTypeValues returns all values of the enum
+func OrderStrategyTypeValues() []OrderStrategyType {
+	return _OrderStrategyTypeValues
+}
+
+// OrderStrategyTypeStrings returns a slice of all String values of the enum
+func OrderStrategyTypeStrings() []string {
+	strs := make([]string, len(_OrderStrategyTypeNames))
+	copy(strs, _OrderStrategyTypeNames)
+	return strs
+}
+
+// IsAOrderStrategyType returns "true" if the value is listed in the enum definition. "false" otherwise
+func (i OrderStrategyType) IsAOrderStrategyType() bool {
+	for _, v := range _OrderStrategyTypeValues {
+		if i == v {
+			return true
+		}
+	}
+	return false
+}
+
+// MarshalJSON implements the json.Marshaler interface for OrderStrategyType
+func (i OrderStrategyType) MarshalJSON() ([]byte, error) {
+	return json.Marshal(i.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for OrderStrategyType
+func (i *OrderStrategyType) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("OrderStrategyType should be a string, got %s", data)
+	}
+
+	var err error
+	*i, err = OrderStrategyTypeString(s)
+	return err
+}
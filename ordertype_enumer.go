@@ -0,0 +1,152 @@
+// Code generated by "enumer -type OrderType -trimprefix OrderType -json -transform snake-upper"; DO NOT EDIT.
+
+package td
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+const _OrderTypeName = "UNSPECIFIEDMARKETLIMITSTOPSTOP_LIMITTRAILING_STOPCABINETNON_MARKETABLEMARKET_ON_CLOSEEXERCISETRAILING_STOP_LIMITNET_DEBITNET_CREDITNET_ZEROLIMIT_ON_CLOSEUNKNOWN"
+
+var _OrderTypeIndex = [...]uint8{0, 11, 17, 22, 26, 36, 49, 56, 70, 85, 93, 112, 121, 131, 139, 153, 160}
+
+const _OrderTypeLowerName = "unspecifiedmarketlimitstopstop_limittrailing_stopcabinetnon_marketablemarket_on_closeexercisetrailing_stop_limitnet_debitnet_creditnet_zerolimit_on_closeunknown"
+
+func (i OrderType) String() string {
+	if i >= OrderType(len(_OrderTypeIndex)-1) {
+		return fmt.Sprintf("OrderType(%d)", i)
+	}
+	return _OrderTypeName[_OrderTypeIndex[i]:_OrderTypeIndex[i+1]]
+}
+
+// An "invalid array index" compiler error signifies that the constant values have changed.
+// Re-run the stringer command to generate them again.
+func _OrderTypeNoOp() {
+	var x [1]struct{}
+	_ = x[OrderTypeUnspecified-(0)]
+	_ = x[OrderTypeMarket-(1)]
+	_ = x[OrderTypeLimit-(2)]
+	_ = x[OrderTypeStop-(3)]
+	_ = x[OrderTypeStopLimit-(4)]
+	_ = x[OrderTypeTrailingStop-(5)]
+	_ = x[OrderTypeCabinet-(6)]
+	_ = x[OrderTypeNonMarketable-(7)]
+	_ = x[OrderTypeMarketOnClose-(8)]
+	_ = x[OrderTypeExercise-(9)]
+	_ = x[OrderTypeTrailingStopLimit-(10)]
+	_ = x[OrderTypeNetDebit-(11)]
+	_ = x[OrderTypeNetCredit-(12)]
+	_ = x[OrderTypeNetZero-(13)]
+	_ = x[OrderTypeLimitOnClose-(14)]
+	_ = x[OrderTypeUnknown-(15)]
+}
+
+var _OrderTypeValues = []OrderType{OrderTypeUnspecified, OrderTypeMarket, OrderTypeLimit, OrderTypeStop, OrderTypeStopLimit, OrderTypeTrailingStop, OrderTypeCabinet, OrderTypeNonMarketable, OrderTypeMarketOnClose, OrderTypeExercise, OrderTypeTrailingStopLimit, OrderTypeNetDebit, OrderTypeNetCredit, OrderTypeNetZero, OrderTypeLimitOnClose, OrderTypeUnknown}
+
+var _OrderTypeNameToValueMap = map[string]OrderType{
+	_OrderTypeName[0:11]:         OrderTypeUnspecified,
+	_OrderTypeLowerName[0:11]:    OrderTypeUnspecified,
+	_OrderTypeName[11:17]:        OrderTypeMarket,
+	_OrderTypeLowerName[11:17]:   OrderTypeMarket,
+	_OrderTypeName[17:22]:        OrderTypeLimit,
+	_OrderTypeLowerName[17:22]:   OrderTypeLimit,
+	_OrderTypeName[22:26]:        OrderTypeStop,
+	_OrderTypeLowerName[22:26]:   OrderTypeStop,
+	_OrderTypeName[26:36]:        OrderTypeStopLimit,
+	_OrderTypeLowerName[26:36]:   OrderTypeStopLimit,
+	_OrderTypeName[36:49]:        OrderTypeTrailingStop,
+	_OrderTypeLowerName[36:49]:   OrderTypeTrailingStop,
+	_OrderTypeName[49:56]:        OrderTypeCabinet,
+	_OrderTypeLowerName[49:56]:   OrderTypeCabinet,
+	_OrderTypeName[56:70]:        OrderTypeNonMarketable,
+	_OrderTypeLowerName[56:70]:   OrderTypeNonMarketable,
+	_OrderTypeName[70:85]:        OrderTypeMarketOnClose,
+	_OrderTypeLowerName[70:85]:   OrderTypeMarketOnClose,
+	_OrderTypeName[85:93]:        OrderTypeExercise,
+	_OrderTypeLowerName[85:93]:   OrderTypeExercise,
+	_OrderTypeName[93:112]:       OrderTypeTrailingStopLimit,
+	_OrderTypeLowerName[93:112]:  OrderTypeTrailingStopLimit,
+	_OrderTypeName[112:121]:      OrderTypeNetDebit,
+	_OrderTypeLowerName[112:121]: OrderTypeNetDebit,
+	_OrderTypeName[121:131]:      OrderTypeNetCredit,
+	_OrderTypeLowerName[121:131]: OrderTypeNetCredit,
+	_OrderTypeName[131:139]:      OrderTypeNetZero,
+	_OrderTypeLowerName[131:139]: OrderTypeNetZero,
+	_OrderTypeName[139:153]:      OrderTypeLimitOnClose,
+	_OrderTypeLowerName[139:153]: OrderTypeLimitOnClose,
+	_OrderTypeName[153:160]:      OrderTypeUnknown,
+	_OrderTypeLowerName[153:160]: OrderTypeUnknown,
+}
+
+var _OrderTypeNames = []string{
+	_OrderTypeName[0:11],
+	_OrderTypeName[11:17],
+	_OrderTypeName[17:22],
+	_OrderTypeName[22:26],
+	_OrderTypeName[26:36],
+	_OrderTypeName[36:49],
+	_OrderTypeName[49:56],
+	_OrderTypeName[56:70],
+	_OrderTypeName[70:85],
+	_OrderTypeName[85:93],
+	_OrderTypeName[93:112],
+	_OrderTypeName[112:121],
+	_OrderTypeName[121:131],
+	_OrderTypeName[131:139],
+	_OrderTypeName[139:153],
+	_OrderTypeName[153:160],
+}
+
+// OrderTypeString retrieves an enum value from the enum constants string name.
+// Throws an error if the param is not part of the enum.
+func OrderTypeString(s string) (OrderType, error) {
+	if val, ok := _OrderTypeNameToValueMap[s]; ok {
+		return val, nil
+	}
+
+	if val, ok := _OrderTypeNameToValueMap[strings.ToLower(s)]; ok {
+		return val, nil
+	}
+	return 0, fmt.Errorf("%s does not belong to OrderType values", s)
+}
+
+// OrderTypeValues returns all values of the enum
+func OrderTypeValues() []OrderType {
+	return _OrderTypeValues
+}
+
+// OrderTypeStrings returns a slice of all String values of the enum
+func OrderTypeStrings() []string {
+	strs := make([]string, len(_OrderTypeNames))
+	copy(strs, _OrderTypeNames)
+	return strs
+}
+
+// IsAOrderType returns "true" if the value is listed in the enum definition. "false" otherwise
+func (i OrderType) IsAOrderType() bool {
+	for _, v := range _OrderTypeValues {
+		if i == v {
+			return true
+		}
+	}
+	return false
+}
+
+// MarshalJSON implements the json.Marshaler interface for OrderType
+func (i OrderType) MarshalJSON() ([]byte, error) {
+	return json.Marshal(i.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for OrderType
+func (i *OrderType) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("OrderType should be a string, got %s", data)
+	}
+
+	var err error
+	*i, err = OrderTypeString(s)
+	return err
+}
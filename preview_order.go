@@ -0,0 +1,177 @@
+package td
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// OrderPreview is what Schwab would do with an order if it were placed
+type OrderPreview struct {
+	OrderID          int64                 `json:"orderId"`
+	OrderStrategy    PreviewOrderStrategy  `json:"orderStrategy"`
+	ValidationResult OrderValidationResult `json:"orderValidationResult"`
+	CommissionAndFee CommissionAndFee      `json:"commissionAndFee"`
+}
+
+type PreviewOrderStrategy struct {
+	AccountNumber          string            `json:"accountNumber"`
+	AdvancedOrderType      string            `json:"advancedOrderType"`
+	OrderStrategyType      OrderStrategyType `json:"orderStrategyType"`
+	OrderVersion           float64           `json:"orderVersion"`
+	Session                OrderSession      `json:"session"`
+	Duration               OrderDuration     `json:"duration"`
+	Status                 OrderStatus       `json:"status"`
+	OrderType              OrderType         `json:"orderType"`
+	Strategy               string            `json:"strategy"`
+	AllOrNone              bool              `json:"allOrNone"`
+	Discretionary          bool              `json:"discretionary"`
+	SellNonMarginableFirst bool              `json:"sellNonMarginableFirst"`
+	SettlementInstruction  string            `json:"settlementInstruction"`
+	AmountIndicator        string            `json:"amountIndicator"`
+	Price                  float64           `json:"price"`
+	Quantity               float64           `json:"quantity"`
+	FilledQuantity         float64           `json:"filledQuantity"`
+	RemainingQuantity      float64           `json:"remainingQuantity"`
+	OrderValue             float64           `json:"orderValue"`
+	OrderBalance           OrderBalance      `json:"orderBalance"`
+	OrderLegs              []PreviewOrderLeg `json:"orderLegs"`
+	EnteredTime            time.Time         `json:"-"`
+	CloseTime              time.Time         `json:"-"`
+}
+
+// OrderBalance is the projected effect the order has on the account
+type OrderBalance struct {
+	OrderValue             float64 `json:"orderValue"`
+	ProjectedAvailableFund float64 `json:"projectedAvailableFund"`
+	ProjectedBuyingPower   float64 `json:"projectedBuyingPower"`
+	ProjectedCommission    float64 `json:"projectedCommission"`
+}
+
+type PreviewOrderLeg struct {
+	LegID               int64       `json:"legId"`
+	AssetType           AssetType   `json:"assetType"`
+	FinalSymbol         string      `json:"finalSymbol"`
+	Instruction         Instruction `json:"instruction"`
+	Quantity            float64     `json:"quantity"`
+	AskPrice            float64     `json:"askPrice"`
+	BidPrice            float64     `json:"bidPrice"`
+	LastPrice           float64     `json:"lastPrice"`
+	MarkPrice           float64     `json:"markPrice"`
+	ProjectedCommission float64     `json:"projectedCommission"`
+}
+
+// OrderValidationResult lists every rule Schwab checked the order against,
+// grouped by outcome. Any rejects mean the order would not be accepted
+type OrderValidationResult struct {
+	Alerts  []OrderValidation `json:"alerts"`
+	Accepts []OrderValidation `json:"accepts"`
+	Rejects []OrderValidation `json:"rejects"`
+	Reviews []OrderValidation `json:"reviews"`
+	Warns   []OrderValidation `json:"warns"`
+}
+
+type OrderValidation struct {
+	ValidationRuleName string `json:"validationRuleName"`
+	Message            string `json:"message"`
+	ActivityMessage    string `json:"activityMessage"`
+	OriginalSeverity   string `json:"originalSeverity"`
+	OverrideName       string `json:"overrideName"`
+	OverrideSeverity   string `json:"overrideSeverity"`
+}
+
+type CommissionAndFee struct {
+	Commission     Commission `json:"commission"`
+	Fee            Fees       `json:"fee"`
+	TrueCommission Commission `json:"trueCommission"`
+}
+
+type Commission struct {
+	CommissionLegs []CommissionLeg `json:"commissionLegs"`
+}
+
+type CommissionLeg struct {
+	CommissionValues []FeeValue `json:"commissionValues"`
+}
+
+type Fees struct {
+	FeeLegs []FeeLeg `json:"feeLegs"`
+}
+
+type FeeLeg struct {
+	FeeValues []FeeValue `json:"feeValues"`
+}
+
+// FeeValue is a single charge. Type is what Schwab calls it, e.g. COMMISSION, SEC_FEE or OPT_REG_FEE
+type FeeValue struct {
+	Value float64 `json:"value"`
+	Type  string  `json:"type"`
+}
+
+// Total commission across every leg
+func (c *Commission) Total() float64 {
+	var sum float64
+	for _, leg := range c.CommissionLegs {
+		for _, v := range leg.CommissionValues {
+			sum += v.Value
+		}
+	}
+
+	return sum
+}
+
+// Total fees across every leg
+func (f *Fees) Total() float64 {
+	var sum float64
+	for _, leg := range f.FeeLegs {
+		for _, v := range leg.FeeValues {
+			sum += v.Value
+		}
+	}
+
+	return sum
+}
+
+func (p *PreviewOrderStrategy) UnmarshalJSON(b []byte) error {
+	type strategy PreviewOrderStrategy
+	x := struct {
+		*strategy
+		EnteredTime orderTime `json:"enteredTime"`
+		CloseTime   orderTime `json:"closeTime"`
+	}{strategy: (*strategy)(p)}
+
+	if err := json.Unmarshal(b, &x); err != nil {
+		return err
+	}
+
+	p.EnteredTime = time.Time(x.EnteredTime)
+	p.CloseTime = time.Time(x.CloseTime)
+	return nil
+}
+
+// PreviewOrder shows what Schwab would do with the order without placing it:
+// projected commission, fees and buying power effect, and any validation
+// warnings or rejections. The order is validated before sending
+func (c *HTTPClient) PreviewOrder(ctx context.Context, hash string, o *Order) (*OrderPreview, error) {
+	switch {
+	case hash == "":
+		return nil, ErrMissingAcctHash
+	case o == nil:
+		return nil, ErrMissingReq
+	}
+
+	if err := o.Validate(); err != nil {
+		c.logger.ErrorContext(ctx, "invalid order", "err", err)
+		return nil, err
+	}
+
+	p := new(OrderPreview)
+	if err := c.do(ctx, http.MethodPost, fmt.Sprintf("/accounts/%s/previewOrder", url.PathEscape(hash)), o, p); err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
@@ -0,0 +1,48 @@
+package td
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestPreviewOrder(t *testing.T) {
+	c := mockHTTP(t, "/accounts/ABCDEF/previewOrder", "", `{
+"orderId":0,
+"orderStrategy":{"accountNumber":"12345678","orderStrategyType":"SINGLE","session":"NORMAL","duration":"DAY","status":"ACCEPTED","orderType":"LIMIT","price":150.25,"quantity":10,
+	"enteredTime":"2024-03-18T14:00:00+0000",
+	"orderBalance":{"orderValue":1502.5,"projectedAvailableFund":8497.5,"projectedBuyingPower":8497.5,"projectedCommission":0},
+	"orderLegs":[{"legId":1,"assetType":"EQUITY","finalSymbol":"AAPL","instruction":"BUY","quantity":10,"askPrice":150.3,"bidPrice":150.2,"lastPrice":150.25,"markPrice":150.25}]},
+"orderValidationResult":{"warns":[{"validationRuleName":"NIGHT_FILLING","message":"Order will be filled in the next session"}]},
+"commissionAndFee":{
+	"commission":{"commissionLegs":[{"commissionValues":[{"value":0,"type":"COMMISSION"}]}]},
+	"fee":{"feeLegs":[{"feeValues":[{"value":0.01,"type":"SEC_FEE"},{"value":0.02,"type":"TAF_FEE"}]}]}}}`)
+
+	o := &Order{
+		OrderType:          OrderTypeLimit,
+		Price:              150.25,
+		OrderLegCollection: []OrderLeg{equityLeg("AAPL", InstructionBuy, 10)},
+	}
+
+	p, err := c.PreviewOrder(context.Background(), "ABCDEF", o)
+	if err != nil {
+		t.Fatalf("should not fail, got %s", err)
+	}
+
+	s := p.OrderStrategy
+	if s.OrderType != OrderTypeLimit || s.Status != OrderStatusAccepted || !s.EnteredTime.Equal(time.Date(2024, 3, 18, 14, 0, 0, 0, time.UTC)) {
+		t.Errorf("order strategy decoded incorrectly: %+v", s)
+	}
+
+	if s.OrderBalance.ProjectedBuyingPower != 8497.5 || len(s.OrderLegs) != 1 || s.OrderLegs[0].Instruction != InstructionBuy {
+		t.Errorf("balance and legs decoded incorrectly: %+v", s)
+	}
+
+	if w := p.ValidationResult.Warns; len(w) != 1 || w[0].ValidationRuleName != "NIGHT_FILLING" {
+		t.Errorf("want a single warning, got %+v", w)
+	}
+
+	if got := p.CommissionAndFee.Fee.Total(); got != 0.03 {
+		t.Errorf("fees should total 0.03, got %v", got)
+	}
+}
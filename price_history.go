@@ -2,9 +2,10 @@ package td
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
-	"net/http"
+	"slices"
 	"time"
 
 	"github.com/google/go-querystring/query"
@@ -16,6 +17,9 @@ var (
 	ErrMissingSymbol        = errors.New("missing symbol")
 	ErrInvalidSymbol        = errors.New("symbols must be 5 characters or less")
 	ErrMissingReq           = errors.New("missing request object")
+	ErrInvalidPeriod        = errors.New("invalid period for period type")
+	ErrInvalidFrequencyType = errors.New("invalid frequency type for period type")
+	ErrInvalidFrequency     = errors.New("invalid frequency for frequency type")
 )
 
 //go:generate enumer -type PeriodType -json -trimprefix PeriodType -transform lower
@@ -53,15 +57,70 @@ type PriceHistoryReq struct {
 	NeedExtendedHoursData bool
 }
 
+// The periods and frequency types Schwab allows for each period type. The
+// first of each is what Schwab defaults to when it's left unset
+var priceHistoryPeriods = map[PeriodType]struct {
+	periods        []int
+	frequencyTypes []FrequencyType
+}{
+	PeriodTypeDay:   {[]int{10, 1, 2, 3, 4, 5}, []FrequencyType{FrequencyTypeMinute}},
+	PeriodTypeMonth: {[]int{1, 2, 3, 6}, []FrequencyType{FrequencyTypeWeekly, FrequencyTypeDaily}},
+	PeriodTypeYear:  {[]int{1, 2, 3, 5, 10, 15, 20}, []FrequencyType{FrequencyTypeMonthly, FrequencyTypeDaily, FrequencyTypeWeekly}},
+	PeriodTypeYTD:   {[]int{1}, []FrequencyType{FrequencyTypeWeekly, FrequencyTypeDaily}},
+}
+
+// The frequencies Schwab allows for each frequency type
+var priceHistoryFrequencies = map[FrequencyType][]int{
+	FrequencyTypeMinute:  {1, 5, 10, 15, 30},
+	FrequencyTypeDaily:   {1},
+	FrequencyTypeWeekly:  {1},
+	FrequencyTypeMonthly: {1},
+}
+
+// resolved returns the period and frequency types Schwab uses for r, which are
+// its defaults for whichever are unset
+func (r *PriceHistoryReq) resolved() (PeriodType, FrequencyType) {
+	periodType := r.PeriodType
+	if periodType == PeriodTypeUnspecified {
+		periodType = PeriodTypeDay
+	}
+
+	frequencyType := r.FrequencyType
+	if allowed, ok := priceHistoryPeriods[periodType]; ok && frequencyType == FrequencyTypeUnspecified {
+		frequencyType = allowed.frequencyTypes[0]
+	}
+
+	return periodType, frequencyType
+}
+
 func (r *PriceHistoryReq) validate() error {
 	switch {
 	case r.Period != 0 && r.PeriodType == PeriodTypeUnspecified:
 		return ErrMissingPeriodType
 	case r.Frequency != 0 && r.FrequencyType == FrequencyTypeUnspecified:
 		return ErrMissingFrequencyType
-	default:
-		return nil
 	}
+
+	// Schwab fills in anything unset with defaults, so check against those
+	periodType, frequencyType := r.resolved()
+	allowed, ok := priceHistoryPeriods[periodType]
+	if !ok {
+		return fmt.Errorf("%w: unknown period type %d", ErrInvalidPeriod, periodType)
+	}
+
+	if r.Period != 0 && !slices.Contains(allowed.periods, r.Period) {
+		return fmt.Errorf("%w: period type %s allows %v, got %d", ErrInvalidPeriod, periodType, allowed.periods, r.Period)
+	}
+
+	if !slices.Contains(allowed.frequencyTypes, frequencyType) {
+		return fmt.Errorf("%w: period type %s allows %v, got %s", ErrInvalidFrequencyType, periodType, allowed.frequencyTypes, frequencyType)
+	}
+
+	if f := priceHistoryFrequencies[frequencyType]; r.Frequency != 0 && !slices.Contains(f, r.Frequency) {
+		return fmt.Errorf("%w: frequency type %s allows %v, got %d", ErrInvalidFrequency, frequencyType, f, r.Frequency)
+	}
+
+	return nil
 }
 
 func (p *PriceHistoryReq) Encode(symbol string) (string, error) {
@@ -101,15 +160,53 @@ func (p *PriceHistoryReq) Encode(symbol string) (string, error) {
 }
 
 type Candle struct {
-	Close    float64 `json:"close"`
-	Datetime int     `json:"datetime"`
-	High     float64 `json:"high"`
-	Low      float64 `json:"low"`
-	Open     float64 `json:"open"`
-	Volume   float64 `json:"volume"`
+	Close    float64   `json:"close"`
+	Datetime time.Time `json:"-"`
+	High     float64   `json:"high"`
+	Low      float64   `json:"low"`
+	Open     float64   `json:"open"`
+	Volume   float64   `json:"volume"`
+}
+
+func (c *Candle) UnmarshalJSON(b []byte) error {
+	type candle Candle
+	x := struct {
+		*candle
+		Datetime epochMillis `json:"datetime"`
+	}{candle: (*candle)(c)}
+
+	if err := json.Unmarshal(b, &x); err != nil {
+		return err
+	}
+
+	c.Datetime = time.Time(x.Datetime)
+	return nil
+}
+
+type PriceHistory struct {
+	Symbol            string    `json:"symbol"`
+	Empty             bool      `json:"empty"`
+	PreviousClose     float64   `json:"previousClose"`
+	PreviousCloseDate time.Time `json:"-"`
+	Candles           []Candle  `json:"candles"`
+}
+
+func (p *PriceHistory) UnmarshalJSON(b []byte) error {
+	type priceHistory PriceHistory
+	x := struct {
+		*priceHistory
+		PreviousCloseDate epochMillis `json:"previousCloseDate"`
+	}{priceHistory: (*priceHistory)(p)}
+
+	if err := json.Unmarshal(b, &x); err != nil {
+		return err
+	}
+
+	p.PreviousCloseDate = time.Time(x.PreviousCloseDate)
+	return nil
 }
 
-func (c *HTTPClient) PriceHistory(ctx context.Context, symbol string, req *PriceHistoryReq) ([]Candle, error) {
+func (c *HTTPClient) PriceHistory(ctx context.Context, symbol string, req *PriceHistoryReq) (*PriceHistory, error) {
 	switch {
 	case symbol == "":
 		return nil, ErrMissingSymbol
@@ -118,6 +215,7 @@ func (c *HTTPClient) PriceHistory(ctx context.Context, symbol string, req *Price
 	}
 
 	if err := req.validate(); err != nil {
+		c.logger.ErrorContext(ctx, "invalid price history request", "err", err)
 		return nil, err
 	}
 
@@ -126,19 +224,13 @@ func (c *HTTPClient) PriceHistory(ctx context.Context, symbol string, req *Price
 		return nil, err
 	}
 
-	type pricehistory struct {
-		Symbol  string   `json:"symbol"`
-		Candles []Candle `json:"candles"`
-		Empty   bool     `json:"empty"`
-	}
-
-	priceHistory := new(pricehistory)
+	priceHistory := new(PriceHistory)
 	u := fmt.Sprintf("/pricehistory?%s", encode)
 
-	err = c.do(ctx, http.MethodGet, u, nil, priceHistory)
+	err = c.marketData(ctx, u, priceHistory)
 	if err != nil {
 		return nil, err
 	}
 
-	return priceHistory.Candles, nil
+	return priceHistory, nil
 }
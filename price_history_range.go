@@ -0,0 +1,155 @@
+package td
+
+import (
+	"context"
+	"iter"
+	"slices"
+	"sync"
+	"time"
+)
+
+// Schwab caps how many minute candles come back per request, so minute
+// ranges are fetched in windows this long. Everything else fits in one request
+const priceHistoryMinuteWindow = 10 * 24 * time.Hour
+
+// windows splits the Start/End range into requests Schwab will answer in full
+func (r *PriceHistoryReq) windows() ([]PriceHistoryReq, error) {
+	switch {
+	case r.Start.IsZero() || r.End.IsZero():
+		return nil, ErrMissingTimeRange
+	case !r.Start.Before(r.End):
+		return nil, ErrInvalidTimeRange
+	}
+
+	if _, frequencyType := r.resolved(); frequencyType != FrequencyTypeMinute {
+		return []PriceHistoryReq{*r}, nil
+	}
+
+	var w []PriceHistoryReq
+	for start := r.Start; start.Before(r.End); {
+		end := start.Add(priceHistoryMinuteWindow)
+		if end.After(r.End) {
+			end = r.End
+		}
+
+		x := *r
+		x.Start, x.End = start, end
+		w = append(w, x)
+		start = end
+	}
+
+	return w, nil
+}
+
+// PriceHistoryRange is PriceHistory for ranges longer than Schwab returns in a
+// single request. The Start/End range is split into windows that are fetched
+// with up to concurrency requests in flight (<1 means one at a time), and the
+// candles are returned in order with the overlap between windows removed
+func (c *HTTPClient) PriceHistoryRange(ctx context.Context, symbol string, req *PriceHistoryReq, concurrency int) ([]Candle, error) {
+	switch {
+	case symbol == "":
+		return nil, ErrMissingSymbol
+	case req == nil:
+		return nil, ErrMissingReq
+	}
+
+	if err := req.validate(); err != nil {
+		return nil, err
+	}
+
+	windows, err := req.windows()
+	if err != nil {
+		c.logger.ErrorContext(ctx, "invalid price history range", "err", err)
+		return nil, err
+	}
+
+	// the first window to fail cancels the rest with its error as the cause, so
+	// that's what gets returned instead of the context.Canceled it leaves behind
+	ctx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+
+	results := make([][]Candle, len(windows))
+	sem := make(chan struct{}, max(concurrency, 1))
+
+	var wg sync.WaitGroup
+	for i := range windows {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+
+		if ctx.Err() != nil {
+			break
+		}
+
+		wg.Add(1)
+		go func() {
+			defer func() { <-sem; wg.Done() }()
+
+			h, err := c.PriceHistory(ctx, symbol, &windows[i])
+			if err != nil {
+				cancel(err)
+				return
+			}
+
+			results[i] = h.Candles
+		}()
+	}
+	wg.Wait()
+
+	if ctx.Err() != nil {
+		return nil, context.Cause(ctx)
+	}
+
+	candles := slices.Concat(results...)
+	slices.SortStableFunc(candles, func(a, b Candle) int { return a.Datetime.Compare(b.Datetime) })
+	return slices.CompactFunc(candles, func(a, b Candle) bool { return a.Datetime.Equal(b.Datetime) }), nil
+}
+
+// PriceHistorySeq is PriceHistoryRange as an iterator, fetching one window at a time
+// as the candles are consumed. Iteration stops after the first error
+func (c *HTTPClient) PriceHistorySeq(ctx context.Context, symbol string, req *PriceHistoryReq) iter.Seq2[Candle, error] {
+	return func(yield func(Candle, error) bool) {
+		switch {
+		case symbol == "":
+			yield(Candle{}, ErrMissingSymbol)
+			return
+		case req == nil:
+			yield(Candle{}, ErrMissingReq)
+			return
+		}
+
+		if err := req.validate(); err != nil {
+			yield(Candle{}, err)
+			return
+		}
+
+		windows, err := req.windows()
+		if err != nil {
+			c.logger.ErrorContext(ctx, "invalid price history range", "err", err)
+			yield(Candle{}, err)
+			return
+		}
+
+		var last time.Time
+		for i := range windows {
+			h, err := c.PriceHistory(ctx, symbol, &windows[i])
+			if err != nil {
+				yield(Candle{}, err)
+				return
+			}
+
+			for _, v := range h.Candles {
+				// windows share their boundary, so skip anything already sent
+				if !v.Datetime.After(last) {
+					continue
+				}
+
+				last = v.Datetime
+				if !yield(v, nil) {
+					return
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,178 @@
+package td
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// mockPriceHistory sends a candle at midnight UTC every day in the requested range, inclusive of both ends
+func mockPriceHistory(t *testing.T) (*HTTPClient, *atomic.Int32) {
+	t.Helper()
+
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+
+		q := r.URL.Query()
+		start, _ := strconv.ParseInt(q.Get("startDate"), 10, 64)
+		end, _ := strconv.ParseInt(q.Get("endDate"), 10, 64)
+
+		var candles []string
+		for ms := start; ms <= end; ms += (24 * time.Hour).Milliseconds() {
+			candles = append(candles, fmt.Sprintf(`{"open":1,"high":2,"low":0.5,"close":1.5,"volume":100,"datetime":%d}`, ms))
+		}
+
+		fmt.Fprintf(w, `{"symbol":%q,"empty":false,"candles":[%s]}`, q.Get("symbol"), strings.Join(candles, ","))
+	}))
+	t.Cleanup(srv.Close)
+
+	return &HTTPClient{marketURL: srv.URL, http: srv.Client(), logger: slog.New(slog.DiscardHandler)}, &calls
+}
+
+func TestPriceHistoryRange(t *testing.T) {
+	c, calls := mockPriceHistory(t)
+
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	req := &PriceHistoryReq{
+		FrequencyType: FrequencyTypeMinute,
+		Frequency:     1,
+		Start:         start,
+		End:           start.AddDate(0, 0, 25),
+	}
+
+	if _, err := c.PriceHistoryRange(context.Background(), "AAPL", &PriceHistoryReq{Start: start}, 2); err != ErrMissingTimeRange {
+		t.Errorf("should require a full range, got %v", err)
+	}
+
+	got, err := c.PriceHistoryRange(context.Background(), "AAPL", req, 2)
+	if err != nil {
+		t.Fatalf("should not fail, got %s", err)
+	}
+
+	if n := calls.Load(); n != 3 {
+		t.Errorf("25 days of minute data should be 3 windows, got %d requests", n)
+	}
+
+	if len(got) != 26 {
+		t.Fatalf("want one candle per day with overlaps removed, got %d", len(got))
+	}
+
+	for i, v := range got {
+		if want := start.AddDate(0, 0, i); !v.Datetime.Equal(want) {
+			t.Fatalf("candle %d out of order: want %s got %s", i, want, v.Datetime)
+		}
+	}
+
+	// a year period type defaults to monthly candles, which fit in one request
+	calls.Store(0)
+	yearly := &PriceHistoryReq{PeriodType: PeriodTypeYear, Start: start, End: start.AddDate(1, 0, 0)}
+	if _, err = c.PriceHistoryRange(context.Background(), "AAPL", yearly, 2); err != nil {
+		t.Fatalf("should not fail, got %s", err)
+	}
+
+	if n := calls.Load(); n != 1 {
+		t.Errorf("a year of monthly data should be 1 request, got %d", n)
+	}
+
+	var n int
+	for v, err := range c.PriceHistorySeq(context.Background(), "AAPL", req) {
+		if err != nil {
+			t.Fatalf("should not fail, got %s", err)
+		}
+
+		if v != got[n] {
+			t.Fatalf("iterator candle %d should match the slice: want %+v got %+v", n, got[n], v)
+		}
+		n++
+	}
+
+	if n != len(got) {
+		t.Errorf("iterator should yield %d candles, got %d", len(got), n)
+	}
+}
+
+func TestPriceHistoryRangeErr(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	failing := start.AddDate(0, 0, 20).UnixMilli()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("startDate") == strconv.FormatInt(failing, 10) {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"errors":[{"id":"6b0e3fb6-8f4e-4c16-9fd3-5c53f10bb0b5","status":400,"title":"Bad request"}]}`)
+			return
+		}
+
+		// the other windows are still in flight when the failing one comes back
+		<-r.Context().Done()
+	}))
+	defer srv.Close()
+
+	c := &HTTPClient{marketURL: srv.URL, http: srv.Client(), logger: slog.New(slog.DiscardHandler)}
+	_, err := c.PriceHistoryRange(context.Background(), "AAPL", &PriceHistoryReq{
+		FrequencyType: FrequencyTypeMinute,
+		Frequency:     1,
+		Start:         start,
+		End:           start.AddDate(0, 0, 25),
+	}, 3)
+
+	var apiErr *HTTPErr
+	if !errors.As(err, &apiErr) || apiErr.Status != http.StatusBadRequest {
+		t.Errorf("want the failing window's API error, got %v", err)
+	}
+}
+
+func TestPriceHistoryReqValidate(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		arg  PriceHistoryReq
+		err  error
+	}{
+		{name: "defaults"},
+		{name: "missing period type", arg: PriceHistoryReq{Period: 1}, err: ErrMissingPeriodType},
+		{name: "missing frequency type", arg: PriceHistoryReq{Frequency: 1}, err: ErrMissingFrequencyType},
+		{name: "day by 5 minutes", arg: PriceHistoryReq{PeriodType: PeriodTypeDay, Period: 5, FrequencyType: FrequencyTypeMinute, Frequency: 5}},
+		{name: "day period 6", arg: PriceHistoryReq{PeriodType: PeriodTypeDay, Period: 6}, err: ErrInvalidPeriod},
+		{name: "day by daily", arg: PriceHistoryReq{PeriodType: PeriodTypeDay, FrequencyType: FrequencyTypeDaily}, err: ErrInvalidFrequencyType},
+		{name: "unset period type defaults to day", arg: PriceHistoryReq{FrequencyType: FrequencyTypeWeekly}, err: ErrInvalidFrequencyType},
+		{name: "minute by 2", arg: PriceHistoryReq{FrequencyType: FrequencyTypeMinute, Frequency: 2}, err: ErrInvalidFrequency},
+		{name: "month by daily", arg: PriceHistoryReq{PeriodType: PeriodTypeMonth, Period: 6, FrequencyType: FrequencyTypeDaily, Frequency: 1}},
+		{name: "month by monthly", arg: PriceHistoryReq{PeriodType: PeriodTypeMonth, FrequencyType: FrequencyTypeMonthly}, err: ErrInvalidFrequencyType},
+		{name: "year by monthly", arg: PriceHistoryReq{PeriodType: PeriodTypeYear, Period: 20, FrequencyType: FrequencyTypeMonthly}},
+		{name: "year period 4", arg: PriceHistoryReq{PeriodType: PeriodTypeYear, Period: 4}, err: ErrInvalidPeriod},
+		{name: "weekly by 2", arg: PriceHistoryReq{PeriodType: PeriodTypeYear, FrequencyType: FrequencyTypeWeekly, Frequency: 2}, err: ErrInvalidFrequency},
+		{name: "ytd period 2", arg: PriceHistoryReq{PeriodType: PeriodTypeYTD, Period: 2}, err: ErrInvalidPeriod},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if err := tc.arg.validate(); !errors.Is(err, tc.err) {
+				t.Errorf("want %v, got %v", tc.err, err)
+			}
+		})
+	}
+}
+
+func TestPriceHistory(t *testing.T) {
+	c := mockHTTP(t, "/pricehistory", "needExtendedHoursData=false&period=1&periodType=day&symbol=AAPL", `{"symbol":"AAPL","empty":false,"previousClose":229.5,"previousCloseDate":1736143200000,
+"candles":[{"open":230,"high":231,"low":229,"close":230.5,"volume":1000,"datetime":1736172000000}]}`)
+
+	got, err := c.PriceHistory(context.Background(), "AAPL", &PriceHistoryReq{PeriodType: PeriodTypeDay, Period: 1})
+	if err != nil {
+		t.Fatalf("should not fail, got %s", err)
+	}
+
+	if got.PreviousClose != 229.5 || !got.PreviousCloseDate.Equal(time.UnixMilli(1736143200000)) {
+		t.Errorf("previous close decoded incorrectly: %+v", got)
+	}
+
+	if len(got.Candles) != 1 || !got.Candles[0].Datetime.Equal(time.UnixMilli(1736172000000)) {
+		t.Errorf("candles decoded incorrectly: %+v", got.Candles)
+	}
+}
@@ -0,0 +1,128 @@
+// Code generated by "enumer -type PriceLinkBasis -trimprefix PriceLinkBasis -json -transform snake-upper"; DO NOT EDIT.
+
+package td
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+const _PriceLinkBasisName = "UNSPECIFIEDMANUALBASETRIGGERLASTBIDASKASK_BIDMARKAVERAGE"
+
+var _PriceLinkBasisIndex = [...]uint8{0, 11, 17, 21, 28, 32, 35, 38, 45, 49, 56}
+
+const _PriceLinkBasisLowerName = "unspecifiedmanualbasetriggerlastbidaskask_bidmarkaverage"
+
+func (i PriceLinkBasis) String() string {
+	if i >= PriceLinkBasis(len(_PriceLinkBasisIndex)-1) {
+		return fmt.Sprintf("PriceLinkBasis(%d)", i)
+	}
+	return _PriceLinkBasisName[_PriceLinkBasisIndex[i]:_PriceLinkBasisIndex[i+1]]
+}
+
+// An "invalid array index" compiler error signifies that the constant values have changed.
+// Re-run the stringer command to generate them again.
+func _PriceLinkBasisNoOp() {
+	var x [1]struct{}
+	_ = x[PriceLinkBasisUnspecified-(0)]
+	_ = x[PriceLinkBasisManual-(1)]
+	_ = x[PriceLinkBasisBase-(2)]
+	_ = x[PriceLinkBasisTrigger-(3)]
+	_ = x[PriceLinkBasisLast-(4)]
+	_ = x[PriceLinkBasisBid-(5)]
+	_ = x[PriceLinkBasisAsk-(6)]
+	_ = x[PriceLinkBasisAskBid-(7)]
+	_ = x[PriceLinkBasisMark-(8)]
+	_ = x[PriceLinkBasisAverage-(9)]
+}
+
+var _PriceLinkBasisValues = []PriceLinkBasis{PriceLinkBasisUnspecified, PriceLinkBasisManual, PriceLinkBasisBase, PriceLinkBasisTrigger, PriceLinkBasisLast, PriceLinkBasisBid, PriceLinkBasisAsk, PriceLinkBasisAskBid, PriceLinkBasisMark, PriceLinkBasisAverage}
+
+var _PriceLinkBasisNameToValueMap = map[string]PriceLinkBasis{
+	_PriceLinkBasisName[0:11]:       PriceLinkBasisUnspecified,
+	_PriceLinkBasisLowerName[0:11]:  PriceLinkBasisUnspecified,
+	_PriceLinkBasisName[11:17]:      PriceLinkBasisManual,
+	_PriceLinkBasisLowerName[11:17]: PriceLinkBasisManual,
+	_PriceLinkBasisName[17:21]:      PriceLinkBasisBase,
+	_PriceLinkBasisLowerName[17:21]: PriceLinkBasisBase,
+	_PriceLinkBasisName[21:28]:      PriceLinkBasisTrigger,
+	_PriceLinkBasisLowerName[21:28]: PriceLinkBasisTrigger,
+	_PriceLinkBasisName[28:32]:      PriceLinkBasisLast,
+	_PriceLinkBasisLowerName[28:32]: PriceLinkBasisLast,
+	_PriceLinkBasisName[32:35]:      PriceLinkBasisBid,
+	_PriceLinkBasisLowerName[32:35]: PriceLinkBasisBid,
+	_PriceLinkBasisName[35:38]:      PriceLinkBasisAsk,
+	_PriceLinkBasisLowerName[35:38]: PriceLinkBasisAsk,
+	_PriceLinkBasisName[38:45]:      PriceLinkBasisAskBid,
+	_PriceLinkBasisLowerName[38:45]: PriceLinkBasisAskBid,
+	_PriceLinkBasisName[45:49]:      PriceLinkBasisMark,
+	_PriceLinkBasisLowerName[45:49]: PriceLinkBasisMark,
+	_PriceLinkBasisName[49:56]:      PriceLinkBasisAverage,
+	_PriceLinkBasisLowerName[49:56]: PriceLinkBasisAverage,
+}
+
+var _PriceLinkBasisNames = []string{
+	_PriceLinkBasisName[0:11],
+	_PriceLinkBasisName[11:17],
+	_PriceLinkBasisName[17:21],
+	_PriceLinkBasisName[21:28],
+	_PriceLinkBasisName[28:32],
+	_PriceLinkBasisName[32:35],
+	_PriceLinkBasisName[35:38],
+	_PriceLinkBasisName[38:45],
+	_PriceLinkBasisName[45:49],
+	_PriceLinkBasisName[49:56],
+}
+
+// PriceLinkBasisString retrieves an enum value from the enum constants string name.
+// Throws an error if the param is not part of the enum.
+func PriceLinkBasisString(s string) (PriceLinkBasis, error) {
+	if val, ok := _PriceLinkBasisNameToValueMap[s]; ok {
+		return val, nil
+	}
+
+	if val, ok := _PriceLinkBasisNameToValueMap[strings.ToLower(s)]; ok {
+		return val, nil
+	}
+	return 0, fmt.Errorf("%s does not belong to PriceLinkBasis values", s)
+}
+
+// PriceLinkBasisValues returns all values of the enum
+func PriceLinkBasisValues() []PriceLinkBasis {
+	return _PriceLinkBasisValues
+}
+
+// PriceLinkBasisStrings returns a slice of all String values of the enum
+func PriceLinkBasisStrings() []string {
+	strs := make([]string, len(_PriceLinkBasisNames))
+	copy(strs, _PriceLinkBasisNames)
+	return strs
+}
+
+// IsAPriceLinkBasis returns "true" if the value is listed in the enum definition. "false" otherwise
+func (i PriceLinkBasis) IsAPriceLinkBasis() bool {
+	for _, v := range _PriceLinkBasisValues {
+		if i == v {
+			return true
+		}
+	}
+	return false
+}
+
+// MarshalJSON implements the json.Marshaler interface for PriceLinkBasis
+func (i PriceLinkBasis) MarshalJSON() ([]byte, error) {
+	return json.Marshal(i.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for PriceLinkBasis
+func (i *PriceLinkBasis) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("PriceLinkBasis should be a string, got %s", data)
+	}
+
+	var err error
+	*i, err = PriceLinkBasisString(s)
+	return err
+}
@@ -2,72 +2,922 @@ package td
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
-	"net/http"
+	"net/url"
+	"strings"
+	"time"
 )
 
+var ErrInvalidQuoteSymbols = errors.New("quote request had invalid symbols")
+
+//go:generate enumer -type QuoteField -trimprefix QuoteField -transform lower
+type QuoteField byte
+
+const (
+	QuoteFieldUnspecified QuoteField = iota
+	QuoteFieldQuote
+	QuoteFieldFundamental
+	QuoteFieldExtended // Pre/post market data
+	QuoteFieldReference
+	QuoteFieldRegular // Regular session data
+)
+
+// QuotesReq picks what GetQuotes sends back. The zero value gets every field
+type QuotesReq struct {
+	Fields []QuoteField
+
+	// Include indicative quotes for ETFs, e.g. $ABC.IV for ABC
+	Indicative bool
+}
+
+func (r *QuotesReq) Encode(symbols []string) (string, error) {
+	q := url.Values{"symbols": {strings.Join(symbols, ",")}}
+	if r.Indicative {
+		q.Set("indicative", "true")
+	}
+
+	if len(r.Fields) == 0 {
+		return q.Encode(), nil
+	}
+
+	fields, err := quoteFields(r.Fields)
+	if err != nil {
+		return "", err
+	}
+
+	q.Set("fields", fields)
+	return q.Encode(), nil
+}
+
+func quoteFields(f []QuoteField) (string, error) {
+	fields := make([]string, len(f))
+	for i, v := range f {
+		if v == QuoteFieldUnspecified || !v.IsAQuoteField() {
+			return "", fmt.Errorf("invalid quote field %d at index %d", v, i)
+		}
+
+		fields[i] = v.String()
+	}
+
+	return strings.Join(fields, ","), nil
+}
+
+// Quote is a quote for a single symbol. Exactly one of the asset
+// pointers is set, matching AssetType, unless it's an asset type this
+// package has no quote for (e.g. bonds). Then they're all nil and Raw holds the quote
 type Quote struct {
-	AssetType                          string  `json:"assetType"`
-	AssetMainType                      string  `json:"assetMainType"`
-	Cusip                              string  `json:"cusip"`
-	AssetSubType                       string  `json:"assetSubType"`
-	Symbol                             string  `json:"symbol"`
-	Description                        string  `json:"description"`
-	BidPrice                           float64 `json:"bidPrice"`
-	BidSize                            float64 `json:"bidSize"`
-	BidID                              string  `json:"bidId"`
-	AskPrice                           float64 `json:"askPrice"`
-	AskSize                            float64 `json:"askSize"`
-	AskID                              string  `json:"askId"`
-	LastPrice                          float64 `json:"lastPrice"`
-	LastSize                           float64 `json:"lastSize"`
-	LastID                             string  `json:"lastId"`
-	OpenPrice                          float64 `json:"openPrice"`
-	HighPrice                          float64 `json:"highPrice"`
-	LowPrice                           float64 `json:"lowPrice"`
-	BidTick                            string  `json:"bidTick"`
-	ClosePrice                         float64 `json:"closePrice"`
-	NetChange                          float64 `json:"netChange"`
-	TotalVolume                        float64 `json:"totalVolume"`
-	QuoteTimeInLong                    int64   `json:"quoteTimeInLong"`
-	TradeTimeInLong                    int64   `json:"tradeTimeInLong"`
-	Mark                               float64 `json:"mark"`
-	Exchange                           string  `json:"exchange"`
-	ExchangeName                       string  `json:"exchangeName"`
-	Marginable                         bool    `json:"marginable"`
-	Shortable                          bool    `json:"shortable"`
-	Volatility                         float64 `json:"volatility"`
-	Digits                             int     `json:"digits"`
-	Five2WkHigh                        float64 `json:"52WkHigh"`
-	Five2WkLow                         float64 `json:"52WkLow"`
-	NAV                                float64 `json:"nAV"`
-	PeRatio                            float64 `json:"peRatio"`
-	DivAmount                          float64 `json:"divAmount"`
-	DivYield                           float64 `json:"divYield"`
-	DivDate                            string  `json:"divDate"`
-	SecurityStatus                     string  `json:"securityStatus"`
-	RegularMarketLastPrice             float64 `json:"regularMarketLastPrice"`
-	RegularMarketLastSize              int     `json:"regularMarketLastSize"`
-	RegularMarketNetChange             float64 `json:"regularMarketNetChange"`
-	RegularMarketTradeTimeInLong       int64   `json:"regularMarketTradeTimeInLong"`
-	NetPercentChangeInDouble           float64 `json:"netPercentChangeInDouble"`
-	MarkChangeInDouble                 float64 `json:"markChangeInDouble"`
-	MarkPercentChangeInDouble          float64 `json:"markPercentChangeInDouble"`
-	RegularMarketPercentChangeInDouble float64 `json:"regularMarketPercentChangeInDouble"`
-	Delayed                            bool    `json:"delayed"`
-}
-
-func (c *HTTPClient) GetQuotes(ctx context.Context, symbol string) (map[string]Quote, error) {
-	if symbol == "" {
-		c.logger.ErrorContext(ctx, "missing symbol to get quote")
+	AssetType AssetType
+	Subtype   AssetSubtype
+	Symbol    string
+	SSID      int64
+	QuoteType string // NBBO for realtime, NFL for non-fee liable (delayed)
+	Realtime  bool
+
+	Equity       *EquityQuote
+	Option       *OptionQuote
+	Future       *FutureQuote
+	FutureOption *FutureOptionQuote
+	Index        *IndexQuote
+	Forex        *ForexQuote
+	MutualFund   *MutualFundQuote
+
+	Raw json.RawMessage // The quote as sent, only when no asset pointer is set
+}
+
+func (q *Quote) UnmarshalJSON(b []byte) error {
+	var x struct {
+		AssetType string `json:"assetMainType"`
+		Subtype   string `json:"assetSubType"`
+		Symbol    string `json:"symbol"`
+		SSID      int64  `json:"ssid"`
+		QuoteType string `json:"quoteType"`
+		Realtime  bool   `json:"realtime"`
+	}
+
+	if err := json.Unmarshal(b, &x); err != nil {
+		return err
+	}
+
+	*q = Quote{Symbol: x.Symbol, SSID: x.SSID, QuoteType: x.QuoteType, Realtime: x.Realtime}
+
+	// one symbol Schwab added a new type for shouldn't fail everything else in the batch
+	assetType, err := AssetTypeString(x.AssetType)
+	if err != nil {
+		q.Raw = append(json.RawMessage(nil), b...)
+		return nil
+	}
+
+	q.AssetType = assetType
+	if subtype, err := AssetSubtypeString(x.Subtype); err == nil {
+		q.Subtype = subtype
+	}
+
+	var target any
+	switch q.AssetType {
+	case AssetTypeEquity:
+		q.Equity = new(EquityQuote)
+		target = q.Equity
+	case AssetTypeOption:
+		q.Option = new(OptionQuote)
+		target = q.Option
+	case AssetTypeFuture:
+		q.Future = new(FutureQuote)
+		target = q.Future
+	case AssetTypeFutureOption:
+		q.FutureOption = new(FutureOptionQuote)
+		target = q.FutureOption
+	case AssetTypeIndex:
+		q.Index = new(IndexQuote)
+		target = q.Index
+	case AssetTypeForex:
+		q.Forex = new(ForexQuote)
+		target = q.Forex
+	case AssetTypeMutualFund:
+		q.MutualFund = new(MutualFundQuote)
+		target = q.MutualFund
+	default:
+		q.Raw = append(json.RawMessage(nil), b...)
+		return nil
+	}
+
+	return json.Unmarshal(b, target)
+}
+
+// QuoteErrors lists what Schwab couldn't find from a quote request
+type QuoteErrors struct {
+	InvalidSymbols []string `json:"invalidSymbols"`
+	InvalidCusips  []string `json:"invalidCusips"`
+	InvalidSSIDs   []int64  `json:"invalidSSIDs"`
+}
+
+func (q *QuoteErrors) Error() string {
+	var s []string
+	if len(q.InvalidSymbols) > 0 {
+		s = append(s, "symbols "+strings.Join(q.InvalidSymbols, ","))
+	}
+
+	if len(q.InvalidCusips) > 0 {
+		s = append(s, "cusips "+strings.Join(q.InvalidCusips, ","))
+	}
+
+	if len(q.InvalidSSIDs) > 0 {
+		s = append(s, fmt.Sprintf("SSIDs %v", q.InvalidSSIDs))
+	}
+
+	return fmt.Sprintf("%s: %s", ErrInvalidQuoteSymbols, strings.Join(s, "; "))
+}
+
+func (q *QuoteErrors) Unwrap() error { return ErrInvalidQuoteSymbols }
+
+// QuotesResp is the response for GetQuotes
+type QuotesResp struct {
+	Quotes map[string]*Quote // Keyed by the symbol requested
+	Errors *QuoteErrors      // Nil when every symbol was found
+}
+
+func (q *QuotesResp) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	*q = QuotesResp{Quotes: make(map[string]*Quote, len(m))}
+	for k, v := range m {
+		if k == "errors" {
+			var e QuoteErrors
+			if err := json.Unmarshal(v, &e); err != nil {
+				return err
+			}
+
+			// merge with any symbol entries already seen
+			if q.Errors == nil {
+				q.Errors = new(QuoteErrors)
+			}
+
+			q.Errors.InvalidSymbols = append(q.Errors.InvalidSymbols, e.InvalidSymbols...)
+			q.Errors.InvalidCusips = append(q.Errors.InvalidCusips, e.InvalidCusips...)
+			q.Errors.InvalidSSIDs = append(q.Errors.InvalidSSIDs, e.InvalidSSIDs...)
+			continue
+		}
+
+		// symbols Schwab doesn't know come back as an entry with a description and no asset type
+		var header struct {
+			AssetType string `json:"assetMainType"`
+		}
+
+		if err := json.Unmarshal(v, &header); err != nil {
+			return err
+		}
+
+		if header.AssetType == "" {
+			if q.Errors == nil {
+				q.Errors = new(QuoteErrors)
+			}
+
+			q.Errors.InvalidSymbols = append(q.Errors.InvalidSymbols, k)
+			continue
+		}
+
+		quote := new(Quote)
+		if err := json.Unmarshal(v, quote); err != nil {
+			return fmt.Errorf("failed decoding quote for %s: %w", k, err)
+		}
+
+		q.Quotes[k] = quote
+	}
+
+	return nil
+}
+
+// epochMillis is how quotes send timestamps. 0 is the zero time
+type epochMillis time.Time
+
+func (e *epochMillis) UnmarshalJSON(b []byte) error {
+	var ms int64
+	if err := json.Unmarshal(b, &ms); err != nil {
+		return err
+	}
+
+	if ms == 0 {
+		*e = epochMillis{}
+		return nil
+	}
+
+	*e = epochMillis(time.UnixMilli(ms))
+	return nil
+}
+
+// QuoteExtended is pre and post market data
+type QuoteExtended struct {
+	AskPrice    float64   `json:"askPrice"`
+	AskSize     int       `json:"askSize"`
+	BidPrice    float64   `json:"bidPrice"`
+	BidSize     int       `json:"bidSize"`
+	LastPrice   float64   `json:"lastPrice"`
+	LastSize    int       `json:"lastSize"`
+	Mark        float64   `json:"mark"`
+	QuoteTime   time.Time `json:"-"`
+	TotalVolume int64     `json:"totalVolume"`
+	TradeTime   time.Time `json:"-"`
+}
+
+func (q *QuoteExtended) UnmarshalJSON(b []byte) error {
+	type extended QuoteExtended
+	x := struct {
+		*extended
+		QuoteTime epochMillis `json:"quoteTime"`
+		TradeTime epochMillis `json:"tradeTime"`
+	}{extended: (*extended)(q)}
+
+	if err := json.Unmarshal(b, &x); err != nil {
+		return err
+	}
+
+	q.QuoteTime, q.TradeTime = time.Time(x.QuoteTime), time.Time(x.TradeTime)
+	return nil
+}
+
+// QuoteRegular is data from the regular session only
+type QuoteRegular struct {
+	LastPrice     float64   `json:"regularMarketLastPrice"`
+	LastSize      int       `json:"regularMarketLastSize"`
+	NetChange     float64   `json:"regularMarketNetChange"`
+	PercentChange float64   `json:"regularMarketPercentChange"`
+	TradeTime     time.Time `json:"-"`
+}
+
+func (q *QuoteRegular) UnmarshalJSON(b []byte) error {
+	type regular QuoteRegular
+	x := struct {
+		*regular
+		TradeTime epochMillis `json:"regularMarketTradeTime"`
+	}{regular: (*regular)(q)}
+
+	if err := json.Unmarshal(b, &x); err != nil {
+		return err
+	}
+
+	q.TradeTime = time.Time(x.TradeTime)
+	return nil
+}
+
+type QuoteFundamental struct {
+	Avg10DaysVolume    float64   `json:"avg10DaysVolume"`
+	Avg1YearVolume     float64   `json:"avg1YearVolume"`
+	DeclarationDate    time.Time `json:"-"`
+	DivAmount          float64   `json:"divAmount"`
+	DivExDate          time.Time `json:"-"`
+	DivFreq            int       `json:"divFreq"` // Payments per year
+	DivPayAmount       float64   `json:"divPayAmount"`
+	DivPayDate         time.Time `json:"-"`
+	DivYield           float64   `json:"divYield"`
+	EPS                float64   `json:"eps"`
+	FundLeverageFactor float64   `json:"fundLeverageFactor"`
+	FundStrategy       string    `json:"fundStrategy"` // A for active, L for leveraged, P for passive, Q for quantitative, S for short
+	NextDivExDate      time.Time `json:"-"`
+	NextDivPayDate     time.Time `json:"-"`
+	PERatio            float64   `json:"peRatio"`
+}
+
+func (q *QuoteFundamental) UnmarshalJSON(b []byte) error {
+	type fundamental QuoteFundamental
+	x := struct {
+		*fundamental
+		DeclarationDate fundamentalTime `json:"declarationDate"`
+		DivExDate       fundamentalTime `json:"divExDate"`
+		DivPayDate      fundamentalTime `json:"divPayDate"`
+		NextDivExDate   fundamentalTime `json:"nextDivExDate"`
+		NextDivPayDate  fundamentalTime `json:"nextDivPayDate"`
+	}{fundamental: (*fundamental)(q)}
+
+	if err := json.Unmarshal(b, &x); err != nil {
+		return err
+	}
+
+	q.DeclarationDate = time.Time(x.DeclarationDate)
+	q.DivExDate = time.Time(x.DivExDate)
+	q.DivPayDate = time.Time(x.DivPayDate)
+	q.NextDivExDate = time.Time(x.NextDivExDate)
+	q.NextDivPayDate = time.Time(x.NextDivPayDate)
+	return nil
+}
+
+type EquityQuote struct {
+	Quote       *EquityQuoteData  `json:"quote"`
+	Extended    *QuoteExtended    `json:"extended"`
+	Fundamental *QuoteFundamental `json:"fundamental"`
+	Reference   *EquityReference  `json:"reference"`
+	Regular     *QuoteRegular     `json:"regular"`
+}
+
+type EquityQuoteData struct {
+	High52Week        float64        `json:"52WeekHigh"`
+	Low52Week         float64        `json:"52WeekLow"`
+	AskMICID          string         `json:"askMICId"`
+	AskPrice          float64        `json:"askPrice"`
+	AskSize           int            `json:"askSize"`
+	AskTime           time.Time      `json:"-"`
+	BidMICID          string         `json:"bidMICId"`
+	BidPrice          float64        `json:"bidPrice"`
+	BidSize           int            `json:"bidSize"`
+	BidTime           time.Time      `json:"-"`
+	ClosePrice        float64        `json:"closePrice"`
+	HighPrice         float64        `json:"highPrice"`
+	LastMICID         string         `json:"lastMICId"`
+	LastPrice         float64        `json:"lastPrice"`
+	LastSize          int            `json:"lastSize"`
+	LowPrice          float64        `json:"lowPrice"`
+	Mark              float64        `json:"mark"`
+	MarkChange        float64        `json:"markChange"`
+	MarkPercentChange float64        `json:"markPercentChange"`
+	NetChange         float64        `json:"netChange"`
+	NetPercentChange  float64        `json:"netPercentChange"`
+	OpenPrice         float64        `json:"openPrice"`
+	QuoteTime         time.Time      `json:"-"`
+	SecurityStatus    SecurityStatus `json:"securityStatus"`
+	TotalVolume       int64          `json:"totalVolume"`
+	TradeTime         time.Time      `json:"-"`
+	Volatility        float64        `json:"volatility"`
+}
+
+func (e *EquityQuoteData) UnmarshalJSON(b []byte) error {
+	type data EquityQuoteData
+	x := struct {
+		*data
+		AskTime   epochMillis `json:"askTime"`
+		BidTime   epochMillis `json:"bidTime"`
+		QuoteTime epochMillis `json:"quoteTime"`
+		TradeTime epochMillis `json:"tradeTime"`
+	}{data: (*data)(e)}
+
+	if err := json.Unmarshal(b, &x); err != nil {
+		return err
+	}
+
+	e.AskTime, e.BidTime = time.Time(x.AskTime), time.Time(x.BidTime)
+	e.QuoteTime, e.TradeTime = time.Time(x.QuoteTime), time.Time(x.TradeTime)
+	return nil
+}
+
+type EquityReference struct {
+	Cusip          string  `json:"cusip"`
+	Description    string  `json:"description"`
+	Exchange       string  `json:"exchange"`
+	ExchangeName   string  `json:"exchangeName"`
+	FSIDesc        string  `json:"fsiDesc"` // Financial status indicator, e.g. deficient or delinquent
+	HTBQuantity    int64   `json:"htbQuantity"`
+	HTBRate        float64 `json:"htbRate"`
+	IsHardToBorrow bool    `json:"isHardToBorrow"`
+	IsShortable    bool    `json:"isShortable"`
+	OTCMarketTier  string  `json:"otcMarketTier"`
+}
+
+type OptionQuote struct {
+	Quote     *OptionQuoteData `json:"quote"`
+	Reference *OptionReference `json:"reference"`
+}
+
+type OptionQuoteData struct {
+	High52Week             float64        `json:"52WeekHigh"`
+	Low52Week              float64        `json:"52WeekLow"`
+	AskPrice               float64        `json:"askPrice"`
+	AskSize                int            `json:"askSize"`
+	BidPrice               float64        `json:"bidPrice"`
+	BidSize                int            `json:"bidSize"`
+	ClosePrice             float64        `json:"closePrice"`
+	Delta                  float64        `json:"delta"`
+	Gamma                  float64        `json:"gamma"`
+	Theta                  float64        `json:"theta"`
+	Vega                   float64        `json:"vega"`
+	Rho                    float64        `json:"rho"`
+	HighPrice              float64        `json:"highPrice"`
+	IndicativeAskPrice     float64        `json:"indAskPrice"` // Only valid for index options
+	IndicativeBidPrice     float64        `json:"indBidPrice"` // Only valid for index options
+	IndicativeQuoteTime    time.Time      `json:"-"`
+	ImpliedYield           float64        `json:"impliedYield"`
+	LastPrice              float64        `json:"lastPrice"`
+	LastSize               int            `json:"lastSize"`
+	LowPrice               float64        `json:"lowPrice"`
+	Mark                   float64        `json:"mark"`
+	MarkChange             float64        `json:"markChange"`
+	MarkPercentChange      float64        `json:"markPercentChange"`
+	MoneyIntrinsicValue    float64        `json:"moneyIntrinsicValue"`
+	NetChange              float64        `json:"netChange"`
+	NetPercentChange       float64        `json:"netPercentChange"`
+	OpenInterest           int64          `json:"openInterest"`
+	OpenPrice              float64        `json:"openPrice"`
+	QuoteTime              time.Time      `json:"-"`
+	SecurityStatus         SecurityStatus `json:"securityStatus"`
+	TheoreticalOptionValue float64        `json:"theoreticalOptionValue"`
+	TimeValue              float64        `json:"timeValue"`
+	TotalVolume            int64          `json:"totalVolume"`
+	TradeTime              time.Time      `json:"-"`
+	UnderlyingPrice        float64        `json:"underlyingPrice"`
+	Volatility             float64        `json:"volatility"`
+}
+
+func (o *OptionQuoteData) UnmarshalJSON(b []byte) error {
+	type data OptionQuoteData
+	x := struct {
+		*data
+		IndicativeQuoteTime epochMillis `json:"indQuoteTime"`
+		QuoteTime           epochMillis `json:"quoteTime"`
+		TradeTime           epochMillis `json:"tradeTime"`
+	}{data: (*data)(o)}
+
+	if err := json.Unmarshal(b, &x); err != nil {
+		return err
+	}
+
+	o.IndicativeQuoteTime = time.Time(x.IndicativeQuoteTime)
+	o.QuoteTime, o.TradeTime = time.Time(x.QuoteTime), time.Time(x.TradeTime)
+	return nil
+}
+
+type OptionReference struct {
+	PutCall          OptionSide               `json:"-"`
+	Cusip            string                   `json:"cusip"`
+	DaysToExpiration int                      `json:"daysToExpiration"`
+	Deliverables     []OptionChainDeliverable `json:"deliverables"`
+	Description      string                   `json:"description"`
+	Exchange         string                   `json:"exchange"`
+	ExchangeName     string                   `json:"exchangeName"`
+	ExerciseType     string                   `json:"exerciseType"` // A for American, E for European
+	ExpirationDay    int                      `json:"expirationDay"`
+	ExpirationMonth  int                      `json:"expirationMonth"`
+	ExpirationYear   int                      `json:"expirationYear"`
+	IsPennyPilot     bool                     `json:"isPennyPilot"`
+	LastTradingDay   time.Time                `json:"-"`
+	Multiplier       float64                  `json:"multiplier"`
+	SettlementType   SettlementType           `json:"settlementType"`
+	StrikePrice      float64                  `json:"strikePrice"`
+	Underlying       string                   `json:"underlying"`
+	ExpirationType   ExpirationType           `json:"uvExpirationType"`
+}
+
+func (o *OptionReference) UnmarshalJSON(b []byte) error {
+	type reference OptionReference
+	x := struct {
+		*reference
+		PutCall        string      `json:"contractType"`
+		LastTradingDay epochMillis `json:"lastTradingDay"`
+	}{reference: (*reference)(o)}
+
+	if err := json.Unmarshal(b, &x); err != nil {
+		return err
+	}
+
+	if x.PutCall != "" {
+		if err := o.PutCall.UnmarshalText(x.PutCall); err != nil {
+			return err
+		}
+	}
+
+	o.LastTradingDay = time.Time(x.LastTradingDay)
+	return nil
+}
+
+type FutureQuote struct {
+	Quote     *FutureQuoteData `json:"quote"`
+	Reference *FutureReference `json:"reference"`
+}
+
+type FutureQuoteData struct {
+	AskMICID         string         `json:"askMICId"`
+	AskPrice         float64        `json:"askPrice"`
+	AskSize          int            `json:"askSize"`
+	AskTime          time.Time      `json:"-"`
+	BidMICID         string         `json:"bidMICId"`
+	BidPrice         float64        `json:"bidPrice"`
+	BidSize          int            `json:"bidSize"`
+	BidTime          time.Time      `json:"-"`
+	ClosePrice       float64        `json:"closePrice"`
+	PercentChange    float64        `json:"futurePercentChange"`
+	HighPrice        float64        `json:"highPrice"`
+	LastMICID        string         `json:"lastMICId"`
+	LastPrice        float64        `json:"lastPrice"`
+	LastSize         int            `json:"lastSize"`
+	LowPrice         float64        `json:"lowPrice"`
+	Mark             float64        `json:"mark"`
+	NetChange        float64        `json:"netChange"`
+	OpenInterest     int64          `json:"openInterest"`
+	OpenPrice        float64        `json:"openPrice"`
+	QuoteTime        time.Time      `json:"-"`
+	QuotedInSession  bool           `json:"quotedInSession"`
+	SecurityStatus   SecurityStatus `json:"securityStatus"`
+	SettleTime       time.Time      `json:"-"`
+	Tick             float64        `json:"tick"`
+	TickAmount       float64        `json:"tickAmount"`
+	TotalVolume      int64          `json:"totalVolume"`
+	TradeTime        time.Time      `json:"-"`
+	NetPercentChange float64        `json:"netPercentChange"`
+}
+
+func (f *FutureQuoteData) UnmarshalJSON(b []byte) error {
+	type data FutureQuoteData
+	x := struct {
+		*data
+		AskTime    epochMillis `json:"askTime"`
+		BidTime    epochMillis `json:"bidTime"`
+		QuoteTime  epochMillis `json:"quoteTime"`
+		SettleTime epochMillis `json:"settleTime"`
+		TradeTime  epochMillis `json:"tradeTime"`
+	}{data: (*data)(f)}
+
+	if err := json.Unmarshal(b, &x); err != nil {
+		return err
+	}
+
+	f.AskTime, f.BidTime = time.Time(x.AskTime), time.Time(x.BidTime)
+	f.QuoteTime, f.TradeTime = time.Time(x.QuoteTime), time.Time(x.TradeTime)
+	f.SettleTime = time.Time(x.SettleTime)
+	return nil
+}
+
+type FutureReference struct {
+	Description     string    `json:"description"`
+	Exchange        string    `json:"exchange"`
+	ExchangeName    string    `json:"exchangeName"`
+	ActiveSymbol    string    `json:"futureActiveSymbol"`
+	ExpirationDate  time.Time `json:"-"`
+	IsActive        bool      `json:"futureIsActive"`
+	Multiplier      float64   `json:"futureMultiplier"`
+	PriceFormat     string    `json:"futurePriceFormat"`
+	SettlementPrice float64   `json:"futureSettlementPrice"`
+	TradingHours    string    `json:"futureTradingHours"`
+	Product         string    `json:"product"`
+}
+
+func (f *FutureReference) UnmarshalJSON(b []byte) error {
+	type reference FutureReference
+	x := struct {
+		*reference
+		ExpirationDate epochMillis `json:"futureExpirationDate"`
+	}{reference: (*reference)(f)}
+
+	if err := json.Unmarshal(b, &x); err != nil {
+		return err
+	}
+
+	f.ExpirationDate = time.Time(x.ExpirationDate)
+	return nil
+}
+
+type FutureOptionQuote struct {
+	Quote     *FutureOptionQuoteData `json:"quote"`
+	Reference *FutureOptionReference `json:"reference"`
+}
+
+type FutureOptionQuoteData struct {
+	AskMICID         string         `json:"askMICId"`
+	AskPrice         float64        `json:"askPrice"`
+	AskSize          int            `json:"askSize"`
+	BidMICID         string         `json:"bidMICId"`
+	BidPrice         float64        `json:"bidPrice"`
+	BidSize          int            `json:"bidSize"`
+	ClosePrice       float64        `json:"closePrice"`
+	HighPrice        float64        `json:"highPrice"`
+	LastMICID        string         `json:"lastMICId"`
+	LastPrice        float64        `json:"lastPrice"`
+	LastSize         int            `json:"lastSize"`
+	LowPrice         float64        `json:"lowPrice"`
+	Mark             float64        `json:"mark"`
+	MarkChange       float64        `json:"markChange"`
+	NetChange        float64        `json:"netChange"`
+	NetPercentChange float64        `json:"netPercentChange"`
+	OpenInterest     int64          `json:"openInterest"`
+	OpenPrice        float64        `json:"openPrice"`
+	QuoteTime        time.Time      `json:"-"`
+	SecurityStatus   SecurityStatus `json:"securityStatus"`
+	SettlementPrice  float64        `json:"settlemetPrice"` // sic
+	Tick             float64        `json:"tick"`
+	TickAmount       float64        `json:"tickAmount"`
+	TotalVolume      int64          `json:"totalVolume"`
+	TradeTime        time.Time      `json:"-"`
+}
+
+func (f *FutureOptionQuoteData) UnmarshalJSON(b []byte) error {
+	type data FutureOptionQuoteData
+	x := struct {
+		*data
+		QuoteTime epochMillis `json:"quoteTime"`
+		TradeTime epochMillis `json:"tradeTime"`
+	}{data: (*data)(f)}
+
+	if err := json.Unmarshal(b, &x); err != nil {
+		return err
+	}
+
+	f.QuoteTime, f.TradeTime = time.Time(x.QuoteTime), time.Time(x.TradeTime)
+	return nil
+}
+
+type FutureOptionReference struct {
+	PutCall         OptionSide `json:"-"`
+	Description     string     `json:"description"`
+	Exchange        string     `json:"exchange"`
+	ExchangeName    string     `json:"exchangeName"`
+	Multiplier      float64    `json:"multiplier"`
+	ExpirationDate  time.Time  `json:"-"`
+	ExpirationStyle string     `json:"expirationStyle"`
+	StrikePrice     float64    `json:"strikePrice"`
+	Underlying      string     `json:"underlying"`
+}
+
+func (f *FutureOptionReference) UnmarshalJSON(b []byte) error {
+	type reference FutureOptionReference
+	x := struct {
+		*reference
+		PutCall        string      `json:"contractType"`
+		ExpirationDate epochMillis `json:"expirationDate"`
+	}{reference: (*reference)(f)}
+
+	if err := json.Unmarshal(b, &x); err != nil {
+		return err
+	}
+
+	if x.PutCall != "" {
+		if err := f.PutCall.UnmarshalText(x.PutCall); err != nil {
+			return err
+		}
+	}
+
+	f.ExpirationDate = time.Time(x.ExpirationDate)
+	return nil
+}
+
+type IndexQuote struct {
+	Quote     *IndexQuoteData `json:"quote"`
+	Reference *IndexReference `json:"reference"`
+}
+
+type IndexQuoteData struct {
+	High52Week       float64        `json:"52WeekHigh"`
+	Low52Week        float64        `json:"52WeekLow"`
+	ClosePrice       float64        `json:"closePrice"`
+	HighPrice        float64        `json:"highPrice"`
+	LastPrice        float64        `json:"lastPrice"`
+	LowPrice         float64        `json:"lowPrice"`
+	NetChange        float64        `json:"netChange"`
+	NetPercentChange float64        `json:"netPercentChange"`
+	OpenPrice        float64        `json:"openPrice"`
+	SecurityStatus   SecurityStatus `json:"securityStatus"`
+	TotalVolume      int64          `json:"totalVolume"`
+	TradeTime        time.Time      `json:"-"`
+}
+
+func (i *IndexQuoteData) UnmarshalJSON(b []byte) error {
+	type data IndexQuoteData
+	x := struct {
+		*data
+		TradeTime epochMillis `json:"tradeTime"`
+	}{data: (*data)(i)}
+
+	if err := json.Unmarshal(b, &x); err != nil {
+		return err
+	}
+
+	i.TradeTime = time.Time(x.TradeTime)
+	return nil
+}
+
+type IndexReference struct {
+	Description  string `json:"description"`
+	Exchange     string `json:"exchange"`
+	ExchangeName string `json:"exchangeName"`
+}
+
+type ForexQuote struct {
+	Quote     *ForexQuoteData `json:"quote"`
+	Reference *ForexReference `json:"reference"`
+}
+
+type ForexQuoteData struct {
+	High52Week       float64        `json:"52WeekHigh"`
+	Low52Week        float64        `json:"52WeekLow"`
+	AskPrice         float64        `json:"askPrice"`
+	AskSize          int            `json:"askSize"`
+	BidPrice         float64        `json:"bidPrice"`
+	BidSize          int            `json:"bidSize"`
+	ClosePrice       float64        `json:"closePrice"`
+	HighPrice        float64        `json:"highPrice"`
+	LastPrice        float64        `json:"lastPrice"`
+	LastSize         int            `json:"lastSize"`
+	LowPrice         float64        `json:"lowPrice"`
+	Mark             float64        `json:"mark"`
+	NetChange        float64        `json:"netChange"`
+	NetPercentChange float64        `json:"netPercentChange"`
+	OpenPrice        float64        `json:"openPrice"`
+	QuoteTime        time.Time      `json:"-"`
+	SecurityStatus   SecurityStatus `json:"securityStatus"`
+	Tick             float64        `json:"tick"`
+	TickAmount       float64        `json:"tickAmount"`
+	TotalVolume      int64          `json:"totalVolume"`
+	TradeTime        time.Time      `json:"-"`
+}
+
+func (f *ForexQuoteData) UnmarshalJSON(b []byte) error {
+	type data ForexQuoteData
+	x := struct {
+		*data
+		QuoteTime epochMillis `json:"quoteTime"`
+		TradeTime epochMillis `json:"tradeTime"`
+	}{data: (*data)(f)}
+
+	if err := json.Unmarshal(b, &x); err != nil {
+		return err
+	}
+
+	f.QuoteTime, f.TradeTime = time.Time(x.QuoteTime), time.Time(x.TradeTime)
+	return nil
+}
+
+type ForexReference struct {
+	Description  string `json:"description"`
+	Exchange     string `json:"exchange"`
+	ExchangeName string `json:"exchangeName"`
+	IsTradable   bool   `json:"isTradable"`
+	MarketMaker  string `json:"marketMaker"`
+	Product      string `json:"product"`
+	TradingHours string `json:"tradingHours"`
+}
+
+type MutualFundQuote struct {
+	Quote       *MutualFundQuoteData `json:"quote"`
+	Fundamental *QuoteFundamental    `json:"fundamental"`
+	Reference   *MutualFundReference `json:"reference"`
+}
+
+type MutualFundQuoteData struct {
+	High52Week       float64        `json:"52WeekHigh"`
+	Low52Week        float64        `json:"52WeekLow"`
+	ClosePrice       float64        `json:"closePrice"`
+	NAV              float64        `json:"nAV"`
+	NetChange        float64        `json:"netChange"`
+	NetPercentChange float64        `json:"netPercentChange"`
+	SecurityStatus   SecurityStatus `json:"securityStatus"`
+	TotalVolume      int64          `json:"totalVolume"`
+	TradeTime        time.Time      `json:"-"`
+}
+
+func (m *MutualFundQuoteData) UnmarshalJSON(b []byte) error {
+	type data MutualFundQuoteData
+	x := struct {
+		*data
+		TradeTime epochMillis `json:"tradeTime"`
+	}{data: (*data)(m)}
+
+	if err := json.Unmarshal(b, &x); err != nil {
+		return err
+	}
+
+	m.TradeTime = time.Time(x.TradeTime)
+	return nil
+}
+
+type MutualFundReference struct {
+	Cusip        string `json:"cusip"`
+	Description  string `json:"description"`
+	Exchange     string `json:"exchange"`
+	ExchangeName string `json:"exchangeName"`
+}
+
+// GetQuotes gets quotes for any mix of equities, options, futures, future options, indices,
+// forex pairs and mutual funds in one request. A nil req gets every field. Symbols Schwab
+// can't find are in the response's Errors rather than failing the whole request
+func (c *HTTPClient) GetQuotes(ctx context.Context, req *QuotesReq, symbols ...string) (*QuotesResp, error) {
+	if len(symbols) == 0 {
+		c.logger.ErrorContext(ctx, "missing symbols to get quotes")
 		return nil, ErrMissingSymbol
 	}
 
-	var q map[string]Quote
-	err := c.do(ctx, http.MethodGet, fmt.Sprintf("/market/quotes?symbol=%s", symbol), nil, &q)
+	for i, v := range symbols {
+		if v == "" {
+			c.logger.ErrorContext(ctx, "empty symbol in quote request", "index", i)
+			return nil, fmt.Errorf("%w at index %d", ErrMissingSymbol, i)
+		}
+	}
+
+	if req == nil {
+		req = &QuotesReq{}
+	}
+
+	q, err := req.Encode(symbols)
 	if err != nil {
+		c.logger.ErrorContext(ctx, "invalid quotes request", "err", err)
+		return nil, err
+	}
+
+	resp := new(QuotesResp)
+	if err = c.marketData(ctx, "/quotes?"+q, resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// QuoteSymbol is anything GetQuote can look up: *OptionID, FutureID,
+// *FutureOptionID, or Ticker for equities, indices and funds
+type QuoteSymbol interface{ String() string }
+
+// Ticker is a plain symbol, e.g. AAPL or $SPX
+type Ticker string
+
+func (t Ticker) String() string { return string(t) }
+
+// GetQuote gets the quote for a single symbol, escaping it into the path so futures and
+// options work as is. No fields gets every field
+func (c *HTTPClient) GetQuote(ctx context.Context, symbol QuoteSymbol, fields ...QuoteField) (*Quote, error) {
+	if symbol == nil {
+		c.logger.ErrorContext(ctx, "missing symbol to get quote")
+		return nil, ErrMissingSymbol
+	}
+
+	if v, ok := symbol.(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			c.logger.ErrorContext(ctx, "invalid symbol to get quote", "err", err)
+			return nil, err
+		}
+	}
+
+	s := symbol.String()
+	if s == "" {
+		c.logger.ErrorContext(ctx, "missing symbol to get quote")
+		return nil, ErrMissingSymbol
+	}
+
+	p := "/" + url.PathEscape(s) + "/quotes"
+	if len(fields) > 0 {
+		x, err := quoteFields(fields)
+		if err != nil {
+			c.logger.ErrorContext(ctx, "invalid quote fields", "err", err)
+			return nil, err
+		}
+
+		p += "?" + url.Values{"fields": {x}}.Encode()
+	}
+
+	resp := new(QuotesResp)
+	if err := c.marketData(ctx, p, resp); err != nil {
 		return nil, err
 	}
 
-	return q, nil
+	if q, ok := resp.Quotes[s]; ok {
+		return q, nil
+	}
+
+	// Schwab may normalize the key, e.g. padding option roots
+	if len(resp.Quotes) == 1 {
+		for _, q := range resp.Quotes {
+			return q, nil
+		}
+	}
+
+	if resp.Errors != nil {
+		return nil, resp.Errors
+	}
+
+	return nil, fmt.Errorf("%w: %s", ErrInvalidQuoteSymbols, s)
 }
@@ -0,0 +1,111 @@
+package td
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestGetQuotes(t *testing.T) {
+	c := mockHTTP(t, "/quotes", "fields=quote%2Creference&symbols=AAPL%2C%2FESZ24%2CAAPL++250117C00230000%2CBAD", `{
+"AAPL":{"assetMainType":"EQUITY","assetSubType":"COE","quoteType":"NBBO","realtime":true,"ssid":1973757747,"symbol":"AAPL",
+	"quote":{"52WeekHigh":237.23,"askPrice":229.6,"bidPrice":229.5,"lastPrice":229.55,"quoteTime":1736200000000,"securityStatus":"Normal","totalVolume":1200},
+	"reference":{"cusip":"037833100","description":"Apple Inc","exchange":"Q","exchangeName":"NASDAQ","isShortable":true}},
+"/ESZ24":{"assetMainType":"FUTURE","symbol":"/ESZ24","realtime":true,"ssid":0,
+	"quote":{"lastPrice":6050.25,"openInterest":2000000,"tickAmount":12.5},
+	"reference":{"futureActiveSymbol":"/ESZ24","futureExpirationDate":1734669000000,"futureMultiplier":50,"product":"/ES"}},
+"AAPL  250117C00230000":{"assetMainType":"OPTION","symbol":"AAPL  250117C00230000","realtime":true,
+	"quote":{"delta":0.49,"openInterest":5000},
+	"reference":{"contractType":"C","exerciseType":"A","settlementType":"P","uvExpirationType":"S","strikePrice":230,"underlying":"AAPL"}},
+"BAD":{"description":"Symbol not found","symbol":"BAD"},
+"912797KS5":{"assetMainType":"BOND","assetSubType":"TBILL","symbol":"912797KS5","realtime":true,"quote":{"lastPrice":99.1}},
+"NEW":{"assetMainType":"SOMETHING_NEW","symbol":"NEW","realtime":true},
+"errors":{"invalidCusips":["123"]}}`)
+
+	got, err := c.GetQuotes(context.Background(), &QuotesReq{
+		Fields: []QuoteField{QuoteFieldQuote, QuoteFieldReference},
+	}, "AAPL", "/ESZ24", "AAPL  250117C00230000", "BAD")
+	if err != nil {
+		t.Fatalf("should not fail, got %s", err)
+	}
+
+	if len(got.Quotes) != 5 {
+		t.Fatalf("want 5 quotes, got %+v", got.Quotes)
+	}
+
+	bond := got.Quotes["912797KS5"]
+	if bond.AssetType != AssetTypeBond || bond.Equity != nil || len(bond.Raw) == 0 || bond.Symbol != "912797KS5" {
+		t.Errorf("asset type without a quote type should be kept raw: %+v", bond)
+	}
+
+	if q := got.Quotes["NEW"]; q.AssetType != AssetTypeUnspecified || len(q.Raw) == 0 || q.Symbol != "NEW" {
+		t.Errorf("unknown asset type should be kept raw: %+v", q)
+	}
+
+	aapl := got.Quotes["AAPL"]
+	if aapl.AssetType != AssetTypeEquity || aapl.Subtype != AssetSubtypeCOE || aapl.Equity == nil {
+		t.Fatalf("equity quote decoded incorrectly: %+v", aapl)
+	}
+
+	if q := aapl.Equity.Quote; q.LastPrice != 229.55 || q.SecurityStatus != SecurityStatusNormal || !q.QuoteTime.Equal(time.UnixMilli(1736200000000)) {
+		t.Errorf("equity quote data decoded incorrectly: %+v", q)
+	}
+
+	if aapl.Equity.Reference.Cusip != "037833100" || aapl.Equity.Fundamental != nil {
+		t.Errorf("only requested fields should be set: %+v", aapl.Equity)
+	}
+
+	es := got.Quotes["/ESZ24"]
+	if es.Future == nil || es.Future.Reference.Multiplier != 50 || es.Future.Reference.ExpirationDate.IsZero() {
+		t.Errorf("future quote decoded incorrectly: %+v", es.Future)
+	}
+
+	opt := got.Quotes["AAPL  250117C00230000"].Option
+	if opt == nil || opt.Reference.PutCall != OptionSideCall || opt.Reference.ExpirationType != ExpirationTypeStandard || opt.Quote.Delta != 0.49 {
+		t.Errorf("option quote decoded incorrectly: %+v", opt)
+	}
+
+	if got.Errors == nil || len(got.Errors.InvalidSymbols) != 1 || got.Errors.InvalidSymbols[0] != "BAD" || len(got.Errors.InvalidCusips) != 1 {
+		t.Fatalf("errors decoded incorrectly: %+v", got.Errors)
+	}
+
+	if !errors.Is(got.Errors, ErrInvalidQuoteSymbols) {
+		t.Errorf("quote errors should unwrap to ErrInvalidQuoteSymbols")
+	}
+}
+
+func TestGetQuote(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.EscapedPath(); got != "/%2FESZ24/quotes" {
+			t.Errorf("future symbol should be path escaped, got %s", got)
+		}
+
+		w.Write([]byte(`{"/ESZ24":{"assetMainType":"FUTURE","symbol":"/ESZ24","realtime":true,
+"quote":{"lastPrice":6050.25,"settleTime":1734669000000},
+"reference":{"futureActiveSymbol":"/ESZ24","futureMultiplier":50,"product":"/ES"}}}`))
+	}))
+	defer srv.Close()
+
+	c := &HTTPClient{marketURL: srv.URL, http: srv.Client(), logger: slog.New(slog.DiscardHandler)}
+
+	if _, err := c.GetQuote(context.Background(), &OptionID{Symbol: "AAPL"}); err != ErrMissingExpiration {
+		t.Errorf("option IDs should be validated, got %v", err)
+	}
+
+	got, err := c.GetQuote(context.Background(), FutureID{Symbol: "ES", Month: time.December, Year: 24})
+	if err != nil {
+		t.Fatalf("should not fail, got %s", err)
+	}
+
+	if got.AssetType != AssetTypeFuture || got.Future == nil || got.Future.Quote.LastPrice != 6050.25 || got.Future.Reference.Product != "/ES" {
+		t.Errorf("future quote decoded incorrectly: %+v", got.Future)
+	}
+
+	if want := time.UnixMilli(1734669000000); !got.Future.Quote.SettleTime.Equal(want) {
+		t.Errorf("want settle time %s, got %s", want, got.Future.Quote.SettleTime)
+	}
+}
@@ -0,0 +1,163 @@
+package td
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+// Schwab refresh tokens die this long after they're issued, and the only way to
+// get a new one is AuthorizeCode
+const RefreshTokenLifetime = 7 * 24 * time.Hour
+
+// How long before the refresh token expires to warn when WithRefreshExpiryWarning
+// isn't given any thresholds
+var DefaultRefreshWarnings = []time.Duration{48 * time.Hour, 24 * time.Hour, time.Hour}
+
+// key in oauth2.Token.Extra that the token stores use to remember when the
+// refresh token was issued
+const refreshIssuedKey = "refresh_token_issued"
+
+// RefreshExpiry is what the refresh expiry warning gets called with
+type RefreshExpiry struct {
+	Issued    time.Time
+	Expires   time.Time
+	Remaining time.Duration // Negative once it's expired
+	Threshold time.Duration // The threshold that was crossed, 0 when it expired
+}
+
+// refreshTracker follows the refresh token in use and the timers for its warnings
+type refreshTracker struct {
+	mu     sync.Mutex
+	token  string
+	issued time.Time
+	timers []*time.Timer
+}
+
+// When the refresh token given to New or Authenticate was issued. Without it, a refresh
+// token the client hasn't seen before (and that doesn't come from a TokenStore) is
+// assumed to have been issued when it's first used
+func WithRefreshTokenIssued(t time.Time) HTTPClientOpt {
+	return func(c *HTTPClient) { c.refreshIssued = t }
+}
+
+// Call fn as the refresh token gets within each threshold of expiring, and once more
+// when it expires, so there's time to run AuthorizeCode. If the token is already
+// past a threshold when the client starts using it, fn is called right away for the
+// closest one. Without this option, the client logs a warning at DefaultRefreshWarnings
+func WithRefreshExpiryWarning(fn func(RefreshExpiry), thresholds ...time.Duration) HTTPClientOpt {
+	return func(c *HTTPClient) {
+		c.onRefreshExpiry = fn
+		if len(thresholds) > 0 {
+			c.refreshWarnings = thresholds
+		}
+	}
+}
+
+// When the refresh token in use was issued, zero before authenticating
+func (c *HTTPClient) RefreshTokenIssued() time.Time {
+	c.refresh.mu.Lock()
+	defer c.refresh.mu.Unlock()
+	return c.refresh.issued
+}
+
+// When the refresh token in use dies, zero before authenticating
+func (c *HTTPClient) RefreshTokenExpiry() time.Time {
+	issued := c.RefreshTokenIssued()
+	if issued.IsZero() {
+		return time.Time{}
+	}
+
+	return issued.Add(RefreshTokenLifetime)
+}
+
+// How long the refresh token in use has left. Negative once it's expired, 0 before authenticating
+func (c *HTTPClient) RefreshTokenRemaining() time.Duration {
+	expires := c.RefreshTokenExpiry()
+	if expires.IsZero() {
+		return 0
+	}
+
+	return time.Until(expires)
+}
+
+// trackRefresh starts tracking token if it's a new refresh token, scheduling the
+// expiry warnings for it. It returns when the tracked refresh token was issued
+func (c *HTTPClient) trackRefresh(token string, issued time.Time) time.Time {
+	c.refresh.mu.Lock()
+	defer c.refresh.mu.Unlock()
+
+	if token == "" || token == c.refresh.token {
+		return c.refresh.issued
+	}
+
+	for _, t := range c.refresh.timers {
+		t.Stop()
+	}
+
+	c.refresh.token, c.refresh.issued, c.refresh.timers = token, issued, nil
+
+	thresholds := c.refreshWarnings
+	if thresholds == nil {
+		thresholds = DefaultRefreshWarnings
+	}
+
+	thresholds = append([]time.Duration{0}, thresholds...)
+	slices.Sort(thresholds)
+	thresholds = slices.Compact(thresholds)
+
+	expires := issued.Add(RefreshTokenLifetime)
+	remaining := time.Until(expires)
+	passed := false
+	for _, threshold := range thresholds {
+		wait := remaining - threshold
+		if wait <= 0 {
+			// only warn about the closest threshold that's already gone by
+			if passed {
+				continue
+			}
+
+			passed, wait = true, 0
+		}
+
+		c.refresh.timers = append(c.refresh.timers, time.AfterFunc(wait, func() {
+			c.refreshExpiring(RefreshExpiry{
+				Issued:    issued,
+				Expires:   expires,
+				Remaining: time.Until(expires),
+				Threshold: threshold,
+			})
+		}))
+	}
+
+	return issued
+}
+
+func (c *HTTPClient) refreshExpiring(e RefreshExpiry) {
+	if c.onRefreshExpiry != nil {
+		c.onRefreshExpiry(e)
+		return
+	}
+
+	ctx := context.Background()
+	l := c.logger.With("issued", e.Issued, "expires", e.Expires, "remaining", e.Remaining)
+	if e.Threshold == 0 {
+		l.ErrorContext(ctx, "refresh token expired, get a new one with AuthorizeCode")
+		return
+	}
+
+	l.WarnContext(ctx, "refresh token expiring soon, get a new one with AuthorizeCode", "threshold", e.Threshold)
+}
+
+// tokenRefreshIssued is when the refresh token in t was issued if a store remembered it
+func tokenRefreshIssued(t *oauth2.Token) time.Time {
+	issued, _ := t.Extra(refreshIssuedKey).(time.Time)
+	return issued
+}
+
+func withRefreshIssued(t *oauth2.Token, issued time.Time) *oauth2.Token {
+	return t.WithExtra(map[string]any{refreshIssuedKey: issued})
+}
@@ -0,0 +1,61 @@
+package td
+
+import (
+	"log/slog"
+	"testing"
+	"time"
+)
+
+func TestRefreshExpiryWarnings(t *testing.T) {
+	warnings := make(chan RefreshExpiry, 10)
+	c := &HTTPClient{logger: slog.New(slog.DiscardHandler)}
+	WithRefreshExpiryWarning(func(e RefreshExpiry) { warnings <- e }, time.Hour, 20*time.Millisecond)(c)
+
+	if c.RefreshTokenRemaining() != 0 || !c.RefreshTokenExpiry().IsZero() {
+		t.Errorf("nothing should be tracked before authenticating")
+	}
+
+	// already inside the hour, so that one fires right away
+	issued := time.Now().Add(50*time.Millisecond - RefreshTokenLifetime)
+	c.trackRefresh("refresh", issued)
+
+	if got := c.RefreshTokenIssued(); !got.Equal(issued) {
+		t.Errorf("want issued %s, got %s", issued, got)
+	}
+
+	if r := c.RefreshTokenRemaining(); r <= 0 || r > 50*time.Millisecond {
+		t.Errorf("remaining should be under 50ms, got %s", r)
+	}
+
+	// the same token again shouldn't reschedule anything
+	c.trackRefresh("refresh", time.Now())
+
+	for _, want := range []time.Duration{time.Hour, 20 * time.Millisecond, 0} {
+		select {
+		case e := <-warnings:
+			if e.Threshold != want {
+				t.Errorf("want threshold %s, got %s", want, e.Threshold)
+			}
+
+			if !e.Issued.Equal(issued) || !e.Expires.Equal(issued.Add(RefreshTokenLifetime)) {
+				t.Errorf("wrong issue/expiry time in %+v", e)
+			}
+
+			if want == 0 && e.Remaining > 0 {
+				t.Errorf("remaining should be negative once expired, got %s", e.Remaining)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("timed out waiting on the %s warning", want)
+		}
+	}
+
+	// a new refresh token stops the old one's warnings
+	c.trackRefresh("refresh2", time.Now().Add(50*time.Millisecond+time.Hour-RefreshTokenLifetime))
+	c.trackRefresh("refresh3", time.Now())
+
+	select {
+	case e := <-warnings:
+		t.Errorf("replaced refresh token shouldn't warn, got %+v", e)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
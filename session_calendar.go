@@ -0,0 +1,162 @@
+package td
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+)
+
+// How far ahead NextOpen and NextClose look before giving up. Long enough to
+// get past any run of holidays and weekends
+const calendarLookahead = 10
+
+// SessionCalendar answers questions about when a market is open. Hours are
+// fetched a day at a time and cached, so repeated questions about the same
+// days don't hit Schwab. Days are in America/New_York. It's safe for concurrent use
+type SessionCalendar struct {
+	h       *HTTPClient
+	market  Market
+	product string
+	loc     *time.Location
+
+	mu   sync.Mutex
+	days map[string]*MarketHours // nil when the market is closed that day
+}
+
+// NewSessionCalendar makes a calendar for a single product in a market, e.g.
+// MarketEquity and EQ, or MarketFuture and /ES. Leave the product empty if the market only has one
+func (c *HTTPClient) NewSessionCalendar(market Market, product string) (*SessionCalendar, error) {
+	if market == MarketUnspecified {
+		return nil, ErrMissingMarket
+	}
+
+	loc, err := newYork()
+	if err != nil {
+		return nil, err
+	}
+
+	return &SessionCalendar{
+		h:       c,
+		market:  market,
+		product: product,
+		loc:     loc,
+		days:    map[string]*MarketHours{},
+	}, nil
+}
+
+// day returns the hours on the day t falls on in New York, or nil if the market is closed
+func (s *SessionCalendar) day(ctx context.Context, t time.Time) (*MarketHours, error) {
+	t = t.In(s.loc)
+	key := t.Format(chainDateFmt)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if m, ok := s.days[key]; ok {
+		return m, nil
+	}
+
+	products, err := s.h.MarketHoursByMarket(ctx, s.market, t)
+	if err != nil {
+		return nil, err
+	}
+
+	m, err := s.pick(products)
+	if err != nil {
+		return nil, fmt.Errorf("%s on %s: %w", s.market, key, err)
+	}
+
+	s.days[key] = m
+	return m, nil
+}
+
+func (s *SessionCalendar) pick(products map[string]MarketHours) (*MarketHours, error) {
+	if m, ok := products[s.product]; ok {
+		if !m.IsOpen {
+			return nil, nil
+		}
+
+		return &m, nil
+	}
+
+	// closed days come back with a single product named after the market
+	for _, v := range products {
+		if !v.IsOpen {
+			return nil, nil
+		}
+	}
+
+	if s.product != "" || len(products) == 0 {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownProduct, s.product)
+	}
+
+	keys := make([]string, 0, len(products))
+	for k := range products {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+
+	m := products[keys[0]]
+	return &m, nil
+}
+
+// Session returns the session the market is in at t, or SessionTypeClosed
+func (s *SessionCalendar) Session(ctx context.Context, t time.Time) (SessionType, error) {
+	// a session can start the evening before the day it belongs to, so check tomorrow too
+	for _, d := range []time.Time{t, t.AddDate(0, 0, 1)} {
+		m, err := s.day(ctx, d)
+		if err != nil {
+			return SessionTypeClosed, err
+		}
+
+		if m == nil {
+			continue
+		}
+
+		if x := m.session(t); x != SessionTypeClosed {
+			return x, nil
+		}
+	}
+
+	return SessionTypeClosed, nil
+}
+
+// IsOpen reports whether the regular session is open at t
+func (s *SessionCalendar) IsOpen(ctx context.Context, t time.Time) (bool, error) {
+	x, err := s.Session(ctx, t)
+	return x == SessionTypeRegularMarket, err
+}
+
+// NextOpen returns the start of the first regular session after t
+func (s *SessionCalendar) NextOpen(ctx context.Context, t time.Time) (time.Time, error) {
+	return s.next(ctx, t, func(x Session) time.Time { return x.Start })
+}
+
+// NextClose returns the end of the first regular session that ends after t.
+// If the market is open at t, that's the end of the current session
+func (s *SessionCalendar) NextClose(ctx context.Context, t time.Time) (time.Time, error) {
+	return s.next(ctx, t, func(x Session) time.Time { return x.End })
+}
+
+func (s *SessionCalendar) next(ctx context.Context, t time.Time, edge func(Session) time.Time) (time.Time, error) {
+	for i := range calendarLookahead {
+		m, err := s.day(ctx, t.AddDate(0, 0, i))
+		if err != nil {
+			return time.Time{}, err
+		}
+
+		if m == nil {
+			continue
+		}
+
+		for _, v := range m.SessionHours.RegularMarket {
+			if x := edge(v); x.After(t) {
+				return x.In(s.loc), nil
+			}
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("%w: %s has no regular session in the %d days after %s", ErrNoSession, s.market, calendarLookahead, t)
+}
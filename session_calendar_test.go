@@ -0,0 +1,92 @@
+package td
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestSessionCalendar(t *testing.T) {
+	var calls int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if r.URL.Path != "/markets/equity" {
+			t.Errorf("wrong path %s", r.URL.Path)
+		}
+
+		date := r.URL.Query().Get("date")
+		d, _ := time.Parse(chainDateFmt, date)
+		if wd := d.Weekday(); wd == time.Saturday || wd == time.Sunday {
+			fmt.Fprintf(w, `{"equity":{"equity":{"date":"%s","marketType":"EQUITY","isOpen":false}}}`, date)
+			return
+		}
+
+		fmt.Fprintf(w, `{"equity":{"EQ":{"date":"%[1]s","marketType":"EQUITY","product":"EQ","productName":"equity","isOpen":true,"sessionHours":{
+"preMarket":[{"start":"%[1]sT07:00:00-04:00","end":"%[1]sT09:30:00-04:00"}],
+"regularMarket":[{"start":"%[1]sT09:30:00-04:00","end":"%[1]sT16:00:00-04:00"}],
+"postMarket":[{"start":"%[1]sT16:00:00-04:00","end":"%[1]sT20:00:00-04:00"}]}}}}`, date)
+	}))
+	defer srv.Close()
+
+	c := &HTTPClient{marketURL: srv.URL, http: srv.Client(), logger: slog.New(slog.DiscardHandler)}
+	cal, err := c.NewSessionCalendar(MarketEquity, "EQ")
+	if err != nil {
+		t.Fatalf("should not fail, got %s", err)
+	}
+
+	ctx := context.Background()
+	loc := cal.loc
+
+	// Friday 2024-04-12
+	friday := func(h, m int) time.Time { return time.Date(2024, 4, 12, h, m, 0, 0, loc) }
+
+	for _, tc := range []struct {
+		at   time.Time
+		want SessionType
+	}{
+		{friday(8, 0), SessionTypePreMarket},
+		{friday(9, 30), SessionTypeRegularMarket},
+		{friday(17, 0), SessionTypePostMarket},
+		{friday(21, 0), SessionTypeClosed},
+	} {
+		got, err := cal.Session(ctx, tc.at)
+		if err != nil {
+			t.Fatalf("should not fail, got %s", err)
+		}
+
+		if got != tc.want {
+			t.Errorf("at %s want %s, got %s", tc.at, tc.want, got)
+		}
+	}
+
+	if open, _ := cal.IsOpen(ctx, friday(12, 0).UTC()); !open {
+		t.Errorf("should be open at noon regardless of the location passed")
+	}
+
+	next, err := cal.NextOpen(ctx, friday(17, 0))
+	if err != nil {
+		t.Fatalf("should not fail, got %s", err)
+	}
+
+	if want := time.Date(2024, 4, 15, 9, 30, 0, 0, loc); !next.Equal(want) {
+		t.Errorf("next open should skip the weekend to %s, got %s", want, next)
+	}
+
+	closes, err := cal.NextClose(ctx, friday(12, 0))
+	if err != nil {
+		t.Fatalf("should not fail, got %s", err)
+	}
+
+	if want := friday(16, 0); !closes.Equal(want) {
+		t.Errorf("next close should be %s, got %s", want, closes)
+	}
+
+	// friday, saturday, sunday and monday
+	if calls != 4 {
+		t.Errorf("days should be cached, want 4 requests got %d", calls)
+	}
+}
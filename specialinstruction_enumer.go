@@ -0,0 +1,104 @@
+// Code generated by "enumer -type SpecialInstruction -trimprefix SpecialInstruction -json -transform snake-upper"; DO NOT EDIT.
+
+package td
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+const _SpecialInstructionName = "UNSPECIFIEDALL_OR_NONEDO_NOT_REDUCEALL_OR_NONE_DO_NOT_REDUCE"
+
+var _SpecialInstructionIndex = [...]uint8{0, 11, 22, 35, 60}
+
+const _SpecialInstructionLowerName = "unspecifiedall_or_nonedo_not_reduceall_or_none_do_not_reduce"
+
+func (i SpecialInstruction) String() string {
+	if i >= SpecialInstruction(len(_SpecialInstructionIndex)-1) {
+		return fmt.Sprintf("SpecialInstruction(%d)", i)
+	}
+	return _SpecialInstructionName[_SpecialInstructionIndex[i]:_SpecialInstructionIndex[i+1]]
+}
+
+// An "invalid array index" compiler error signifies that the constant values have changed.
+// Re-run the stringer command to generate them again.
+func _SpecialInstructionNoOp() {
+	var x [1]struct{}
+	_ = x[SpecialInstructionUnspecified-(0)]
+	_ = x[SpecialInstructionAllOrNone-(1)]
+	_ = x[SpecialInstructionDoNotReduce-(2)]
+	_ = x[SpecialInstructionAllOrNoneDoNotReduce-(3)]
+}
+
+var _SpecialInstructionValues = []SpecialInstruction{SpecialInstructionUnspecified, SpecialInstructionAllOrNone, SpecialInstructionDoNotReduce, SpecialInstructionAllOrNoneDoNotReduce}
+
+var _SpecialInstructionNameToValueMap = map[string]SpecialInstruction{
+	_SpecialInstructionName[0:11]:       SpecialInstructionUnspecified,
+	_SpecialInstructionLowerName[0:11]:  SpecialInstructionUnspecified,
+	_SpecialInstructionName[11:22]:      SpecialInstructionAllOrNone,
+	_SpecialInstructionLowerName[11:22]: SpecialInstructionAllOrNone,
+	_SpecialInstructionName[22:35]:      SpecialInstructionDoNotReduce,
+	_SpecialInstructionLowerName[22:35]: SpecialInstructionDoNotReduce,
+	_SpecialInstructionName[35:60]:      SpecialInstructionAllOrNoneDoNotReduce,
+	_SpecialInstructionLowerName[35:60]: SpecialInstructionAllOrNoneDoNotReduce,
+}
+
+var _SpecialInstructionNames = []string{
+	_SpecialInstructionName[0:11],
+	_SpecialInstructionName[11:22],
+	_SpecialInstructionName[22:35],
+	_SpecialInstructionName[35:60],
+}
+
+// SpecialInstructionString retrieves an enum value from the enum constants string name.
+// Throws an error if the param is not part of the enum.
+func SpecialInstructionString(s string) (SpecialInstruction, error) {
+	if val, ok := _SpecialInstructionNameToValueMap[s]; ok {
+		return val, nil
+	}
+
+	if val, ok := _SpecialInstructionNameToValueMap[strings.ToLower(s)]; ok {
+		return val, nil
+	}
+	return 0, fmt.Errorf("%s does not belong to SpecialInstruction values", s)
+}
+
+// SpecialInstructionValues returns all values of the enum
+func SpecialInstructionValues() []SpecialInstruction {
+	return _SpecialInstructionValues
+}
+
+// SpecialInstructionStrings returns a slice of all String values of the enum
+func SpecialInstructionStrings() []string {
+	strs := make([]string, len(_SpecialInstructionNames))
+	copy(strs, _SpecialInstructionNames)
+	return strs
+}
+
+// IsASpecialInstruction returns "true" if the value is listed in the enum definition. "false" otherwise
+func (i SpecialInstruction) IsASpecialInstruction() bool {
+	for _, v := range _SpecialInstructionValues {
+		if i == v {
+			return true
+		}
+	}
+	return false
+}
+
+// MarshalJSON implements the json.Marshaler interface for SpecialInstruction
+func (i SpecialInstruction) MarshalJSON() ([]byte, error) {
+	return json.Marshal(i.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for SpecialInstruction
+func (i *SpecialInstruction) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("SpecialInstruction should be a string, got %s", data)
+	}
+
+	var err error
+	*i, err = SpecialInstructionString(s)
+	return err
+}
@@ -2,7 +2,6 @@ package tests
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"log/slog"
 	"os"
@@ -10,7 +9,6 @@ import (
 	"time"
 
 	"github.com/AnthonyHewins/td"
-	"golang.org/x/oauth2"
 	"gopkg.in/yaml.v2"
 )
 
@@ -53,33 +51,6 @@ func (c controller) ctx() (context.Context, context.CancelFunc) {
 	return context.WithTimeout(context.Background(), c.timeout)
 }
 
-func readToken() (oauth2.Token, error) {
-	b, err := os.ReadFile("./token.json")
-	if err != nil {
-		return oauth2.Token{}, err
-	}
-
-	var t oauth2.Token
-	if err := json.Unmarshal(b, &t); err != nil {
-		return oauth2.Token{}, err
-	}
-
-	if time.Now().After(t.Expiry) {
-		return oauth2.Token{}, fmt.Errorf("token expired")
-	}
-
-	return t, nil
-}
-
-func writeToken(t oauth2.Token) error {
-	buf, err := json.Marshal(t)
-	if err != nil {
-		return err
-	}
-
-	return os.WriteFile("./token.json", buf, 0777)
-}
-
 func newController(ctx context.Context) (*controller, error) {
 	b, err := os.ReadFile(os.Getenv("CONFIG"))
 	if err != nil {
@@ -96,34 +67,23 @@ func newController(ctx context.Context) (*controller, error) {
 		Level:     slog.Level(conf.LogLevel),
 	})
 
-	t, err := readToken()
-	if err != nil {
-		fmt.Println("failed reading token from cache:", err)
-	}
-
 	hc, err := td.New(
 		ctx,
 		td.ProdURL,
 		td.AuthUrl,
 		conf.Key,
 		conf.Secret,
-		t.RefreshToken,
+		conf.RefreshToken,
 		td.WithClientLogger(logger),
-		td.WithHTTPAccessToken(t.AccessToken),
+		td.WithTokenStore(td.NewFileTokenStore("./token.json")),
 	)
 	if err != nil {
 		return nil, err
 	}
 
-	if t.AccessToken == "" {
-		t, err = hc.Authenticate(ctx, conf.RefreshToken)
-		if err != nil {
-			return nil, err
-		}
-	}
-
-	if err = writeToken(t); err != nil {
-		fmt.Println("failed writing token to cache:", err)
+	t, err := hc.Authenticate(ctx, conf.RefreshToken)
+	if err != nil {
+		return nil, err
 	}
 
 	c = &controller{
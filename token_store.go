@@ -0,0 +1,243 @@
+package td
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/pbkdf2"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+const (
+	tokenFilePerm = 0600
+
+	// OWASP's recommendation for PBKDF2-HMAC-SHA256
+	tokenKeyIterations = 600_000
+	tokenSaltLen       = 16
+)
+
+var (
+	ErrNoToken           = errors.New("no token stored")
+	ErrMissingPassphrase = errors.New("missing passphrase for encrypted token store")
+	ErrInvalidTokenFile  = errors.New("token file is corrupt or the passphrase is wrong")
+)
+
+// TokenStore persists tokens so refresh tokens survive restarts. Give one to
+// the HTTPClient with WithTokenStore: it's loaded when authenticating and saved
+// to every time the token is refreshed. Load returns ErrNoToken when there's nothing saved.
+//
+// The saved token's Extra holds when its refresh token was issued, for the
+// refresh expiry warnings. The file stores keep it; other stores should too,
+// otherwise a loaded refresh token is assumed to be brand new
+type TokenStore interface {
+	Load(ctx context.Context) (*oauth2.Token, error)
+	Save(ctx context.Context, t *oauth2.Token) error
+}
+
+// FileTokenStore keeps the token as JSON in a file only the current user can read
+type FileTokenStore struct{ path string }
+
+func NewFileTokenStore(path string) *FileTokenStore { return &FileTokenStore{path: path} }
+
+func (f *FileTokenStore) Load(ctx context.Context) (*oauth2.Token, error) {
+	b, err := readTokenFile(f.path)
+	if err != nil {
+		return nil, err
+	}
+
+	return unmarshalToken(b)
+}
+
+func (f *FileTokenStore) Save(ctx context.Context, t *oauth2.Token) error {
+	b, err := marshalToken(t)
+	if err != nil {
+		return err
+	}
+
+	return writeTokenFile(f.path, b)
+}
+
+// EncryptedFileTokenStore is a FileTokenStore encrypted at rest with AES-GCM,
+// using a key derived from a passphrase. The file is the salt, then the nonce,
+// then the ciphertext
+type EncryptedFileTokenStore struct {
+	path       string
+	passphrase string
+	iterations int
+
+	// deriving the key is deliberately slow, so it's done once per salt
+	mu   sync.Mutex
+	salt []byte
+	aead cipher.AEAD
+}
+
+func NewEncryptedFileTokenStore(path, passphrase string) (*EncryptedFileTokenStore, error) {
+	if passphrase == "" {
+		return nil, ErrMissingPassphrase
+	}
+
+	return &EncryptedFileTokenStore{path: path, passphrase: passphrase, iterations: tokenKeyIterations}, nil
+}
+
+// cipher returns the AEAD for salt, deriving a new key if the salt changed
+func (e *EncryptedFileTokenStore) cipher(salt []byte) (cipher.AEAD, error) {
+	if e.aead != nil && string(e.salt) == string(salt) {
+		return e.aead, nil
+	}
+
+	key, err := pbkdf2.Key(sha256.New, e.passphrase, salt, e.iterations, 32)
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	e.salt, e.aead = salt, aead
+	return aead, nil
+}
+
+func (e *EncryptedFileTokenStore) Load(ctx context.Context) (*oauth2.Token, error) {
+	b, err := readTokenFile(e.path)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(b) < tokenSaltLen {
+		return nil, ErrInvalidTokenFile
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	aead, err := e.cipher(b[:tokenSaltLen])
+	if err != nil {
+		return nil, err
+	}
+
+	b = b[tokenSaltLen:]
+	n := aead.NonceSize()
+	if len(b) < n {
+		return nil, ErrInvalidTokenFile
+	}
+
+	plain, err := aead.Open(nil, b[:n], b[n:], nil)
+	if err != nil {
+		return nil, ErrInvalidTokenFile
+	}
+
+	return unmarshalToken(plain)
+}
+
+func (e *EncryptedFileTokenStore) Save(ctx context.Context, t *oauth2.Token) error {
+	plain, err := marshalToken(t)
+	if err != nil {
+		return err
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	salt := e.salt
+	if salt == nil {
+		salt = make([]byte, tokenSaltLen)
+		rand.Read(salt)
+	}
+
+	aead, err := e.cipher(salt)
+	if err != nil {
+		return err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	rand.Read(nonce)
+
+	b := make([]byte, 0, len(salt)+len(nonce)+len(plain)+aead.Overhead())
+	b = append(b, salt...)
+	b = append(b, nonce...)
+	return writeTokenFile(e.path, aead.Seal(b, nonce, plain, nil))
+}
+
+// storedToken is the token on disk, along with when its refresh token was
+// issued since oauth2.Token doesn't keep that
+type storedToken struct {
+	*oauth2.Token
+	RefreshIssued time.Time `json:"refresh_token_issued,omitzero"`
+}
+
+func marshalToken(t *oauth2.Token) ([]byte, error) {
+	return json.Marshal(storedToken{Token: t, RefreshIssued: tokenRefreshIssued(t)})
+}
+
+func unmarshalToken(b []byte) (*oauth2.Token, error) {
+	x := storedToken{Token: new(oauth2.Token)}
+	if err := json.Unmarshal(b, &x); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidTokenFile, err)
+	}
+
+	if x.RefreshIssued.IsZero() {
+		return x.Token, nil
+	}
+
+	return withRefreshIssued(x.Token, x.RefreshIssued), nil
+}
+
+func readTokenFile(path string) ([]byte, error) {
+	b, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, ErrNoToken
+	}
+
+	return b, err
+}
+
+// writeTokenFile writes to a temp file in the same directory and renames it
+// over path, so a crash mid write never leaves a truncated token behind
+func writeTokenFile(path string, b []byte) error {
+	f, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+
+	tmp := f.Name()
+	defer os.Remove(tmp) // no-op once renamed
+
+	if err = f.Chmod(tokenFilePerm); err != nil {
+		f.Close()
+		return err
+	}
+
+	if _, err = f.Write(b); err != nil {
+		f.Close()
+		return err
+	}
+
+	if err = f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+
+	if err = f.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, path)
+}
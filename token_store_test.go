@@ -0,0 +1,212 @@
+package td
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+func testTokenStore(t *testing.T, store TokenStore, path string) {
+	t.Helper()
+	ctx := context.Background()
+
+	if _, err := store.Load(ctx); err != ErrNoToken {
+		t.Fatalf("empty store should return ErrNoToken, got %v", err)
+	}
+
+	issued := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	want := withRefreshIssued(&oauth2.Token{
+		AccessToken:  "access",
+		TokenType:    "Bearer",
+		RefreshToken: "refresh",
+		Expiry:       time.Date(2025, 1, 1, 0, 30, 0, 0, time.UTC),
+	}, issued)
+
+	for range 2 {
+		if err := store.Save(ctx, want); err != nil {
+			t.Fatalf("should not fail saving, got %s", err)
+		}
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatalf("token file should exist, got %s", err)
+	}
+
+	if perm := info.Mode().Perm(); perm != tokenFilePerm {
+		t.Errorf("token file should be %o, got %o", tokenFilePerm, perm)
+	}
+
+	if entries, _ := os.ReadDir(filepath.Dir(path)); len(entries) != 1 {
+		t.Errorf("temp files should be cleaned up, got %v", entries)
+	}
+
+	got, err := store.Load(ctx)
+	if err != nil {
+		t.Fatalf("should not fail loading, got %s", err)
+	}
+
+	if got.AccessToken != want.AccessToken || got.RefreshToken != want.RefreshToken || !got.Expiry.Equal(want.Expiry) {
+		t.Errorf("want %+v, got %+v", want, got)
+	}
+
+	if got := tokenRefreshIssued(got); !got.Equal(issued) {
+		t.Errorf("refresh token issue time should be kept, want %s got %s", issued, got)
+	}
+}
+
+func TestFileTokenStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "token.json")
+	testTokenStore(t, NewFileTokenStore(path), path)
+}
+
+func TestEncryptedFileTokenStore(t *testing.T) {
+	if _, err := NewEncryptedFileTokenStore("x", ""); err != ErrMissingPassphrase {
+		t.Errorf("should require a passphrase, got %v", err)
+	}
+
+	path := filepath.Join(t.TempDir(), "token.enc")
+	store, _ := NewEncryptedFileTokenStore(path, "hunter2")
+	store.iterations = 1000 // keep the test fast
+
+	testTokenStore(t, store, path)
+
+	b, _ := os.ReadFile(path)
+	if bytes.Contains(b, []byte("refresh")) {
+		t.Errorf("token should be encrypted at rest, got %q", b)
+	}
+
+	wrong, _ := NewEncryptedFileTokenStore(path, "hunter3")
+	wrong.iterations = 1000
+	if _, err := wrong.Load(context.Background()); !errors.Is(err, ErrInvalidTokenFile) {
+		t.Errorf("wrong passphrase should fail, got %v", err)
+	}
+}
+
+type memTokenStore struct{ t *oauth2.Token }
+
+func (m *memTokenStore) Load(context.Context) (*oauth2.Token, error) {
+	if m.t == nil {
+		return nil, ErrNoToken
+	}
+
+	return m.t, nil
+}
+
+func (m *memTokenStore) Save(_ context.Context, t *oauth2.Token) error {
+	m.t = t
+	return nil
+}
+
+func TestAuthenticateTokenStore(t *testing.T) {
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := calls.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"access_token":"access%d","token_type":"Bearer","expires_in":1800,"refresh_token":"refresh"}`, n)
+	}))
+	defer srv.Close()
+
+	store := &memTokenStore{}
+	c := &HTTPClient{
+		logger:    slog.New(slog.DiscardHandler),
+		store:     store,
+		oauthConf: oauth2.Config{Endpoint: oauth2.Endpoint{TokenURL: srv.URL, AuthStyle: oauth2.AuthStyleInParams}},
+	}
+
+	ctx := context.WithValue(context.Background(), oauth2.HTTPClient, srv.Client())
+	if _, err := c.Authenticate(ctx, ""); err != ErrMissingRefresh {
+		t.Fatalf("empty store and no refresh token should fail, got %v", err)
+	}
+
+	tkn, err := c.Authenticate(ctx, "refresh")
+	if err != nil {
+		t.Fatalf("should not fail, got %s", err)
+	}
+
+	if store.t == nil || store.t.AccessToken != tkn.AccessToken {
+		t.Fatalf("fetched token should be saved, got %+v", store.t)
+	}
+
+	issued := c.RefreshTokenIssued()
+	if got := tokenRefreshIssued(store.t); issued.IsZero() || !got.Equal(issued) {
+		t.Errorf("refresh token issue time should be saved, want %s got %s", issued, got)
+	}
+
+	// the stored access token is still valid, so nothing should be fetched
+	if tkn, err = c.Authenticate(ctx, ""); err != nil {
+		t.Fatalf("should not fail, got %s", err)
+	}
+
+	if n := calls.Load(); n != 1 || tkn.AccessToken != "access1" {
+		t.Errorf("stored token should be reused, got %d fetches and token %s", n, tkn.AccessToken)
+	}
+}
+
+func TestAuthenticatePrefersStoredToken(mainTest *testing.T) {
+	testCases := []struct {
+		name   string
+		stored string
+		arg    string
+		want   []string // refresh tokens sent, in order
+		err    bool
+	}{
+		{name: "stored rotated token wins over the one given", stored: "rotated", arg: "original", want: []string{"rotated"}},
+		{name: "falls back to the one given", stored: "dead", arg: "original", want: []string{"dead", "original"}},
+		{name: "no fallback without one given", stored: "dead", want: []string{"dead"}, err: true},
+		{name: "no fallback when they match", stored: "dead", arg: "dead", want: []string{"dead"}, err: true},
+	}
+
+	for _, tc := range testCases {
+		mainTest.Run(tc.name, func(t *testing.T) {
+			var sent []string
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				refresh := r.FormValue("refresh_token")
+				sent = append(sent, refresh)
+
+				w.Header().Set("Content-Type", "application/json")
+				if refresh == "dead" {
+					w.WriteHeader(http.StatusBadRequest)
+					fmt.Fprint(w, `{"error":"invalid_grant"}`)
+					return
+				}
+
+				fmt.Fprintf(w, `{"access_token":"access","token_type":"Bearer","expires_in":1800,"refresh_token":%q}`, refresh)
+			}))
+			defer srv.Close()
+
+			store := &memTokenStore{t: &oauth2.Token{RefreshToken: tc.stored}}
+			c := &HTTPClient{
+				logger:    slog.New(slog.DiscardHandler),
+				store:     store,
+				oauthConf: oauth2.Config{Endpoint: oauth2.Endpoint{TokenURL: srv.URL, AuthStyle: oauth2.AuthStyleInParams}},
+			}
+
+			ctx := context.WithValue(context.Background(), oauth2.HTTPClient, srv.Client())
+			tkn, err := c.Authenticate(ctx, tc.arg)
+			if gotErr := err != nil; gotErr != tc.err {
+				t.Fatalf("want error %t, got %v", tc.err, err)
+			}
+
+			if !reflect.DeepEqual(sent, tc.want) {
+				t.Errorf("want refresh tokens %v sent, got %v", tc.want, sent)
+			}
+
+			if !tc.err && tkn.RefreshToken != tc.want[len(tc.want)-1] {
+				t.Errorf("should be using %s, got %s", tc.want[len(tc.want)-1], tkn.RefreshToken)
+			}
+		})
+	}
+}
@@ -0,0 +1,112 @@
+// Code generated by "enumer -type TransactionActivityType -trimprefix TransactionActivityType -json -transform snake-upper"; DO NOT EDIT.
+
+package td
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+const _TransactionActivityTypeName = "UNSPECIFIEDACTIVITY_CORRECTIONEXECUTIONORDER_ACTIONTRANSFERUNKNOWN"
+
+var _TransactionActivityTypeIndex = [...]uint8{0, 11, 30, 39, 51, 59, 66}
+
+const _TransactionActivityTypeLowerName = "unspecifiedactivity_correctionexecutionorder_actiontransferunknown"
+
+func (i TransactionActivityType) String() string {
+	if i >= TransactionActivityType(len(_TransactionActivityTypeIndex)-1) {
+		return fmt.Sprintf("TransactionActivityType(%d)", i)
+	}
+	return _TransactionActivityTypeName[_TransactionActivityTypeIndex[i]:_TransactionActivityTypeIndex[i+1]]
+}
+
+// An "invalid array index" compiler error signifies that the constant values have changed.
+// Re-run the stringer command to generate them again.
+func _TransactionActivityTypeNoOp() {
+	var x [1]struct{}
+	_ = x[TransactionActivityTypeUnspecified-(0)]
+	_ = x[TransactionActivityTypeActivityCorrection-(1)]
+	_ = x[TransactionActivityTypeExecution-(2)]
+	_ = x[TransactionActivityTypeOrderAction-(3)]
+	_ = x[TransactionActivityTypeTransfer-(4)]
+	_ = x[TransactionActivityTypeUnknown-(5)]
+}
+
+var _TransactionActivityTypeValues = []TransactionActivityType{TransactionActivityTypeUnspecified, TransactionActivityTypeActivityCorrection, TransactionActivityTypeExecution, TransactionActivityTypeOrderAction, TransactionActivityTypeTransfer, TransactionActivityTypeUnknown}
+
+var _TransactionActivityTypeNameToValueMap = map[string]TransactionActivityType{
+	_TransactionActivityTypeName[0:11]:       TransactionActivityTypeUnspecified,
+	_TransactionActivityTypeLowerName[0:11]:  TransactionActivityTypeUnspecified,
+	_TransactionActivityTypeName[11:30]:      TransactionActivityTypeActivityCorrection,
+	_TransactionActivityTypeLowerName[11:30]: TransactionActivityTypeActivityCorrection,
+	_TransactionActivityTypeName[30:39]:      TransactionActivityTypeExecution,
+	_TransactionActivityTypeLowerName[30:39]: TransactionActivityTypeExecution,
+	_TransactionActivityTypeName[39:51]:      TransactionActivityTypeOrderAction,
+	_TransactionActivityTypeLowerName[39:51]: TransactionActivityTypeOrderAction,
+	_TransactionActivityTypeName[51:59]:      TransactionActivityTypeTransfer,
+	_TransactionActivityTypeLowerName[51:59]: TransactionActivityTypeTransfer,
+	_TransactionActivityTypeName[59:66]:      TransactionActivityTypeUnknown,
+	_TransactionActivityTypeLowerName[59:66]: TransactionActivityTypeUnknown,
+}
+
+var _TransactionActivityTypeNames = []string{
+	_TransactionActivityTypeName[0:11],
+	_TransactionActivityTypeName[11:30],
+	_TransactionActivityTypeName[30:39],
+	_TransactionActivityTypeName[39:51],
+	_TransactionActivityTypeName[51:59],
+	_TransactionActivityTypeName[59:66],
+}
+
+// TransactionActivityTypeString retrieves an enum value from the enum constants string name.
+// Throws an error if the param is not part of the enum.
+func TransactionActivityTypeString(s string) (TransactionActivityType, error) {
+	if val, ok := _TransactionActivityTypeNameToValueMap[s]; ok {
+		return val, nil
+	}
+
+	if val, ok := _TransactionActivityTypeNameToValueMap[strings.ToLower(s)]; ok {
+		return val, nil
+	}
+	return 0, fmt.Errorf("%s does not belong to TransactionActivityType values", s)
+}
+
+// TransactionActivityTypeValues returns all values of the enum
+func TransactionActivityTypeValues() []TransactionActivityType {
+	return _TransactionActivityTypeValues
+}
+
+// TransactionActivityTypeStrings returns a slice of all String values of the enum
+func TransactionActivityTypeStrings() []string {
+	strs := make([]string, len(_TransactionActivityTypeNames))
+	copy(strs, _TransactionActivityTypeNames)
+	return strs
+}
+
+// IsATransactionActivityType returns "true" if the value is listed in the enum definition. "false" otherwise
+func (i TransactionActivityType) IsATransactionActivityType() bool {
+	for _, v := range _TransactionActivityTypeValues {
+		if i == v {
+			return true
+		}
+	}
+	return false
+}
+
+// MarshalJSON implements the json.Marshaler interface for TransactionActivityType
+func (i TransactionActivityType) MarshalJSON() ([]byte, error) {
+	return json.Marshal(i.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for TransactionActivityType
+func (i *TransactionActivityType) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("TransactionActivityType should be a string, got %s", data)
+	}
+
+	var err error
+	*i, err = TransactionActivityTypeString(s)
+	return err
+}
@@ -0,0 +1,283 @@
+package td
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/go-querystring/query"
+)
+
+var (
+	ErrMissingTransactionID = errors.New("missing transaction ID")
+)
+
+//go:generate enumer -type TransactionType -trimprefix TransactionType -json -transform snake-upper
+type TransactionType byte
+
+const (
+	TransactionTypeUnspecified TransactionType = iota
+	TransactionTypeTrade
+	TransactionTypeReceiveAndDeliver
+	TransactionTypeDividendOrInterest
+	TransactionTypeAchReceipt
+	TransactionTypeAchDisbursement
+	TransactionTypeCashReceipt
+	TransactionTypeCashDisbursement
+	TransactionTypeElectronicFund
+	TransactionTypeWireOut
+	TransactionTypeWireIn
+	TransactionTypeJournal
+	TransactionTypeMemorandum
+	TransactionTypeMarginCall
+	TransactionTypeMoneyMarket
+	TransactionTypeSmaAdjustment
+)
+
+//go:generate enumer -type TransactionStatus -trimprefix TransactionStatus -json -transform snake-upper
+type TransactionStatus byte
+
+const (
+	TransactionStatusUnspecified TransactionStatus = iota
+	TransactionStatusValid
+	TransactionStatusInvalid
+	TransactionStatusPending
+	TransactionStatusUnknown
+)
+
+//go:generate enumer -type SubAccount -trimprefix SubAccount -json -transform snake-upper
+type SubAccount byte
+
+const (
+	SubAccountUnspecified SubAccount = iota
+	SubAccountCash
+	SubAccountMargin
+	SubAccountShort
+	SubAccountDiv
+	SubAccountIncome
+	SubAccountUnknown
+)
+
+//go:generate enumer -type TransactionActivityType -trimprefix TransactionActivityType -json -transform snake-upper
+type TransactionActivityType byte
+
+const (
+	TransactionActivityTypeUnspecified TransactionActivityType = iota
+	TransactionActivityTypeActivityCorrection
+	TransactionActivityTypeExecution
+	TransactionActivityTypeOrderAction
+	TransactionActivityTypeTransfer
+	TransactionActivityTypeUnknown
+)
+
+type Transaction struct {
+	ActivityID     int64                   `json:"activityId"`
+	Time           time.Time               `json:"-"`
+	TradeDate      time.Time               `json:"-"`
+	SettlementDate time.Time               `json:"-"`
+	User           TransactionUser         `json:"user"`
+	Description    string                  `json:"description"`
+	AccountNumber  string                  `json:"accountNumber"`
+	Type           TransactionType         `json:"type"`
+	Status         TransactionStatus       `json:"status"`
+	SubAccount     SubAccount              `json:"subAccount"`
+	PositionID     int64                   `json:"positionId"`
+	OrderID        int64                   `json:"orderId"`
+	NetAmount      float64                 `json:"netAmount"`
+	ActivityType   TransactionActivityType `json:"activityType"`
+	TransferItems  []TransferItem          `json:"transferItems"`
+}
+
+type TransactionUser struct {
+	CdDomainID     string `json:"cdDomainId"`
+	Login          string `json:"login"`
+	Type           string `json:"type"` // ADVISOR_USER, BROKER_USER, CLIENT_USER, SYSTEM_USER or UNKNOWN
+	UserID         int64  `json:"userId"`
+	SystemUserName string `json:"systemUserName"`
+	FirstName      string `json:"firstName"`
+	LastName       string `json:"lastName"`
+	BrokerRepCode  string `json:"brokerRepCode"`
+}
+
+// TransferItem is a single movement of cash or securities in a transaction.
+// Trades usually have one for the security and one per fee charged
+type TransferItem struct {
+	Instrument     Instrument     `json:"instrument"`
+	Amount         float64        `json:"amount"`
+	Cost           float64        `json:"cost"`
+	Price          float64        `json:"price"`
+	FeeType        string         `json:"feeType"` // e.g. COMMISSION, SEC_FEE, OPT_REG_FEE
+	PositionEffect PositionEffect `json:"positionEffect"`
+}
+
+func (t *Transaction) UnmarshalJSON(b []byte) error {
+	type transaction Transaction
+	x := struct {
+		*transaction
+		Time           orderTime `json:"time"`
+		TradeDate      orderTime `json:"tradeDate"`
+		SettlementDate orderTime `json:"settlementDate"`
+	}{transaction: (*transaction)(t)}
+
+	if err := json.Unmarshal(b, &x); err != nil {
+		return err
+	}
+
+	t.Time = time.Time(x.Time)
+	t.TradeDate = time.Time(x.TradeDate)
+	t.SettlementDate = time.Time(x.SettlementDate)
+	return nil
+}
+
+// TransactionsReq filters ListTransactions. Start and End are required
+type TransactionsReq struct {
+	Start, End time.Time
+	Symbol     string
+	Types      []TransactionType // Every type when empty
+}
+
+func (r *TransactionsReq) validate() error {
+	switch {
+	case r.Start.IsZero() || r.End.IsZero():
+		return ErrMissingTimeRange
+	case !r.Start.Before(r.End):
+		return ErrInvalidTimeRange
+	default:
+		return nil
+	}
+}
+
+func (r *TransactionsReq) encode(start, end time.Time) (string, error) {
+	types := r.Types
+	if len(types) == 0 {
+		types = TransactionTypeValues()[1:]
+	}
+
+	s := make([]string, len(types))
+	for i, v := range types {
+		s[i] = v.String()
+	}
+
+	req := struct {
+		StartDate string `url:"startDate"`
+		EndDate   string `url:"endDate"`
+		Symbol    string `url:"symbol,omitempty"`
+		Types     string `url:"types"`
+	}{
+		StartDate: start.UTC().Format(orderQueryTimeFmt),
+		EndDate:   end.UTC().Format(orderQueryTimeFmt),
+		Symbol:    r.Symbol,
+		Types:     strings.Join(s, ","),
+	}
+
+	q, err := query.Values(req)
+	if err != nil {
+		return "", err
+	}
+
+	return q.Encode(), nil
+}
+
+func transactionsPath(hash string) string {
+	return fmt.Sprintf("/accounts/%s/transactions", url.PathEscape(hash))
+}
+
+// ListTransactions lists transactions on the account with the hash given.
+// Ranges wider than the year Schwab allows in one request are split into
+// multiple requests and stitched back together in chronological window order
+func (c *HTTPClient) ListTransactions(ctx context.Context, hash string, req *TransactionsReq) ([]Transaction, error) {
+	switch {
+	case hash == "":
+		return nil, ErrMissingAcctHash
+	case req == nil:
+		return nil, ErrMissingReq
+	}
+
+	if err := req.validate(); err != nil {
+		return nil, err
+	}
+
+	// Schwab documents a 1 year maximum range per request, so longer ones are split
+	type key struct{ id, nanos int64 }
+
+	var all []Transaction
+	var boundary map[key]struct{}
+	for start := req.Start; start.Before(req.End); {
+		end := start.AddDate(1, 0, 0)
+		if end.After(req.End) {
+			end = req.End
+		}
+
+		encode, err := req.encode(start, end)
+		if err != nil {
+			return nil, err
+		}
+
+		var t []Transaction
+		if err = c.do(ctx, http.MethodGet, fmt.Sprintf("%s?%s", transactionsPath(hash), encode), nil, &t); err != nil {
+			return nil, err
+		}
+
+		// windows share their boundary, so a transaction on it can come back twice
+		next := map[key]struct{}{}
+		for _, v := range t {
+			k := key{v.ActivityID, v.Time.UnixNano()}
+			if v.ActivityID != 0 && v.Time.Equal(start) {
+				if _, ok := boundary[k]; ok {
+					continue
+				}
+			}
+
+			if v.ActivityID != 0 && v.Time.Equal(end) {
+				next[k] = struct{}{}
+			}
+
+			all = append(all, v)
+		}
+
+		boundary, start = next, end
+	}
+
+	return all, nil
+}
+
+func (c *HTTPClient) GetTransaction(ctx context.Context, hash string, id int64) (*Transaction, error) {
+	switch {
+	case hash == "":
+		return nil, ErrMissingAcctHash
+	case id == 0:
+		return nil, ErrMissingTransactionID
+	}
+
+	var raw json.RawMessage
+	if err := c.do(ctx, http.MethodGet, fmt.Sprintf("%s/%d", transactionsPath(hash), id), nil, &raw); err != nil {
+		return nil, err
+	}
+
+	// documented as a single transaction, but sometimes sent as a list of one
+	if raw = bytes.TrimSpace(raw); len(raw) > 0 && raw[0] == '[' {
+		var t []Transaction
+		if err := json.Unmarshal(raw, &t); err != nil {
+			return nil, err
+		}
+
+		if len(t) == 0 {
+			return nil, fmt.Errorf("transaction %d not found", id)
+		}
+
+		return &t[0], nil
+	}
+
+	t := new(Transaction)
+	if err := json.Unmarshal(raw, t); err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
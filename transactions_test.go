@@ -0,0 +1,105 @@
+package td
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestListTransactions(t *testing.T) {
+	start := time.Date(2022, 7, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC)
+
+	var windows []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/accounts/ABCDEF/transactions" {
+			t.Errorf("wrong path %s", r.URL.Path)
+		}
+
+		q := r.URL.Query()
+		if q.Get("types") != "TRADE,DIVIDEND_OR_INTEREST" || q.Get("symbol") != "AAPL" {
+			t.Errorf("filters should be sent, got %s", r.URL.RawQuery)
+		}
+
+		windows = append(windows, q.Get("startDate"))
+		n := len(windows)
+		from, _ := time.Parse(orderQueryTimeFmt, q.Get("startDate"))
+		to, _ := time.Parse(orderQueryTimeFmt, q.Get("endDate"))
+
+		// every window has its own trade, a transfer with no ID and a dividend on each
+		// of its boundaries; the one on the start boundary was sent by the last window too
+		var boundary string
+		if n > 1 {
+			boundary = fmt.Sprintf(`{"activityId":%d,"time":%q,"type":"DIVIDEND_OR_INTEREST","status":"VALID","subAccount":"CASH","activityType":"TRANSFER","netAmount":2.4},`,
+				500+n-1, from.Format(orderTimeFmt))
+		}
+
+		fmt.Fprintf(w, `[%s
+{"activityId":%d,"time":%q,"type":"TRADE","status":"VALID","subAccount":"CASH","activityType":"EXECUTION","netAmount":-1502.5,
+	"transferItems":[{"instrument":{"assetType":"EQUITY","symbol":"AAPL","cusip":"037833100"},"amount":10,"cost":-1502.5,"price":150.25,"positionEffect":"OPENING"},
+		{"instrument":{"assetType":"CURRENCY","symbol":"CURRENCY_USD"},"amount":0,"cost":0,"feeType":"COMMISSION"}]},
+{"time":%q,"type":"DIVIDEND_OR_INTEREST","status":"VALID","subAccount":"CASH","activityType":"TRANSFER","netAmount":1},
+{"activityId":%d,"time":%q,"type":"DIVIDEND_OR_INTEREST","status":"VALID","subAccount":"CASH","activityType":"TRANSFER","netAmount":2.4}]`,
+			boundary,
+			n, from.Add(24*time.Hour).Format(orderTimeFmt),
+			from.Add(48*time.Hour).Format(orderTimeFmt),
+			500+n, to.Format(orderTimeFmt),
+		)
+	}))
+	defer srv.Close()
+
+	c := &HTTPClient{baseURL: srv.URL, http: srv.Client(), logger: slog.New(slog.DiscardHandler)}
+	got, err := c.ListTransactions(context.Background(), "ABCDEF", &TransactionsReq{
+		Start:  start,
+		End:    end,
+		Symbol: "AAPL",
+		Types:  []TransactionType{TransactionTypeTrade, TransactionTypeDividendOrInterest},
+	})
+	if err != nil {
+		t.Fatalf("should not fail, got %s", err)
+	}
+
+	// Schwab allows a year per request
+	if len(windows) != 3 {
+		t.Errorf("2.5 years should be split into 3 requests, got %d", len(windows))
+	}
+
+	if windows[0] != "2022-07-01T00:00:00.000Z" || windows[1] != "2023-07-01T00:00:00.000Z" {
+		t.Errorf("windows should be consecutive, got %v", windows)
+	}
+
+	// 3 trades, 3 transfers without IDs and 3 boundary dividends
+	if len(got) != 9 {
+		t.Fatalf("only boundary duplicates should be dropped, want 9 got %d", len(got))
+	}
+
+	trade := got[0]
+	if trade.Type != TransactionTypeTrade || trade.ActivityType != TransactionActivityTypeExecution || !trade.Time.Equal(start.Add(24*time.Hour)) {
+		t.Errorf("trade decoded incorrectly: %+v", trade)
+	}
+
+	if len(trade.TransferItems) != 2 || trade.TransferItems[0].Instrument.AssetType != AssetTypeEquity || trade.TransferItems[1].FeeType != "COMMISSION" {
+		t.Errorf("transfer items decoded incorrectly: %+v", trade.TransferItems)
+	}
+
+	if _, err = c.ListTransactions(context.Background(), "ABCDEF", &TransactionsReq{Start: end, End: start}); err != ErrInvalidTimeRange {
+		t.Errorf("backwards range should fail with %s, got %v", ErrInvalidTimeRange, err)
+	}
+}
+
+func TestGetTransaction(t *testing.T) {
+	c := mockHTTP(t, "/accounts/ABCDEF/transactions/5", "", `[{"activityId":5,"type":"JOURNAL","status":"VALID"}]`)
+
+	got, err := c.GetTransaction(context.Background(), "ABCDEF", 5)
+	if err != nil {
+		t.Fatalf("should not fail, got %s", err)
+	}
+
+	if got.ActivityID != 5 || got.Type != TransactionTypeJournal {
+		t.Errorf("transaction decoded incorrectly: %+v", got)
+	}
+}
@@ -0,0 +1,152 @@
+// Code generated by "enumer -type TransactionType -trimprefix TransactionType -json -transform snake-upper"; DO NOT EDIT.
+
+package td
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+const _TransactionTypeName = "UNSPECIFIEDTRADERECEIVE_AND_DELIVERDIVIDEND_OR_INTERESTACH_RECEIPTACH_DISBURSEMENTCASH_RECEIPTCASH_DISBURSEMENTELECTRONIC_FUNDWIRE_OUTWIRE_INJOURNALMEMORANDUMMARGIN_CALLMONEY_MARKETSMA_ADJUSTMENT"
+
+var _TransactionTypeIndex = [...]uint8{0, 11, 16, 35, 55, 66, 82, 94, 111, 126, 134, 141, 148, 158, 169, 181, 195}
+
+const _TransactionTypeLowerName = "unspecifiedtradereceive_and_deliverdividend_or_interestach_receiptach_disbursementcash_receiptcash_disbursementelectronic_fundwire_outwire_injournalmemorandummargin_callmoney_marketsma_adjustment"
+
+func (i TransactionType) String() string {
+	if i >= TransactionType(len(_TransactionTypeIndex)-1) {
+		return fmt.Sprintf("TransactionType(%d)", i)
+	}
+	return _TransactionTypeName[_TransactionTypeIndex[i]:_TransactionTypeIndex[i+1]]
+}
+
+// An "invalid array index" compiler error signifies that the constant values have changed.
+// Re-run the stringer command to generate them again.
+func _TransactionTypeNoOp() {
+	var x [1]struct{}
+	_ = x[TransactionTypeUnspecified-(0)]
+	_ = x[TransactionTypeTrade-(1)]
+	_ = x[TransactionTypeReceiveAndDeliver-(2)]
+	_ = x[TransactionTypeDividendOrInterest-(3)]
+	_ = x[TransactionTypeAchReceipt-(4)]
+	_ = x[TransactionTypeAchDisbursement-(5)]
+	_ = x[TransactionTypeCashReceipt-(6)]
+	_ = x[TransactionTypeCashDisbursement-(7)]
+	_ = x[TransactionTypeElectronicFund-(8)]
+	_ = x[TransactionTypeWireOut-(9)]
+	_ = x[TransactionTypeWireIn-(10)]
+	_ = x[TransactionTypeJournal-(11)]
+	_ = x[TransactionTypeMemorandum-(12)]
+	_ = x[TransactionTypeMarginCall-(13)]
+	_ = x[TransactionTypeMoneyMarket-(14)]
+	_ = x[TransactionTypeSmaAdjustment-(15)]
+}
+
+var _TransactionTypeValues = []TransactionType{TransactionTypeUnspecified, TransactionTypeTrade, TransactionTypeReceiveAndDeliver, TransactionTypeDividendOrInterest, TransactionTypeAchReceipt, TransactionTypeAchDisbursement, TransactionTypeCashReceipt, TransactionTypeCashDisbursement, TransactionTypeElectronicFund, TransactionTypeWireOut, TransactionTypeWireIn, TransactionTypeJournal, TransactionTypeMemorandum, TransactionTypeMarginCall, TransactionTypeMoneyMarket, TransactionTypeSmaAdjustment}
+
+var _TransactionTypeNameToValueMap = map[string]TransactionType{
+	_TransactionTypeName[0:11]:         TransactionTypeUnspecified,
+	_TransactionTypeLowerName[0:11]:    TransactionTypeUnspecified,
+	_TransactionTypeName[11:16]:        TransactionTypeTrade,
+	_TransactionTypeLowerName[11:16]:   TransactionTypeTrade,
+	_TransactionTypeName[16:35]:        TransactionTypeReceiveAndDeliver,
+	_TransactionTypeLowerName[16:35]:   TransactionTypeReceiveAndDeliver,
+	_TransactionTypeName[35:55]:        TransactionTypeDividendOrInterest,
+	_TransactionTypeLowerName[35:55]:   TransactionTypeDividendOrInterest,
+	_TransactionTypeName[55:66]:        TransactionTypeAchReceipt,
+	_TransactionTypeLowerName[55:66]:   TransactionTypeAchReceipt,
+	_TransactionTypeName[66:82]:        TransactionTypeAchDisbursement,
+	_TransactionTypeLowerName[66:82]:   TransactionTypeAchDisbursement,
+	_TransactionTypeName[82:94]:        TransactionTypeCashReceipt,
+	_TransactionTypeLowerName[82:94]:   TransactionTypeCashReceipt,
+	_TransactionTypeName[94:111]:       TransactionTypeCashDisbursement,
+	_TransactionTypeLowerName[94:111]:  TransactionTypeCashDisbursement,
+	_TransactionTypeName[111:126]:      TransactionTypeElectronicFund,
+	_TransactionTypeLowerName[111:126]: TransactionTypeElectronicFund,
+	_TransactionTypeName[126:134]:      TransactionTypeWireOut,
+	_TransactionTypeLowerName[126:134]: TransactionTypeWireOut,
+	_TransactionTypeName[134:141]:      TransactionTypeWireIn,
+	_TransactionTypeLowerName[134:141]: TransactionTypeWireIn,
+	_TransactionTypeName[141:148]:      TransactionTypeJournal,
+	_TransactionTypeLowerName[141:148]: TransactionTypeJournal,
+	_TransactionTypeName[148:158]:      TransactionTypeMemorandum,
+	_TransactionTypeLowerName[148:158]: TransactionTypeMemorandum,
+	_TransactionTypeName[158:169]:      TransactionTypeMarginCall,
+	_TransactionTypeLowerName[158:169]: TransactionTypeMarginCall,
+	_TransactionTypeName[169:181]:      TransactionTypeMoneyMarket,
+	_TransactionTypeLowerName[169:181]: TransactionTypeMoneyMarket,
+	_TransactionTypeName[181:195]:      TransactionTypeSmaAdjustment,
+	_TransactionTypeLowerName[181:195]: TransactionTypeSmaAdjustment,
+}
+
+var _TransactionTypeNames = []string{
+	_TransactionTypeName[0:11],
+	_TransactionTypeName[11:16],
+	_TransactionTypeName[16:35],
+	_TransactionTypeName[35:55],
+	_TransactionTypeName[55:66],
+	_TransactionTypeName[66:82],
+	_TransactionTypeName[82:94],
+	_TransactionTypeName[94:111],
+	_TransactionTypeName[111:126],
+	_TransactionTypeName[126:134],
+	_TransactionTypeName[134:141],
+	_TransactionTypeName[141:148],
+	_TransactionTypeName[148:158],
+	_TransactionTypeName[158:169],
+	_TransactionTypeName[169:181],
+	_TransactionTypeName[181:195],
+}
+
+// TransactionTypeString retrieves an enum value from the enum constants string name.
+// Throws an error if the param is not part of the enum.
+func TransactionTypeString(s string) (TransactionType, error) {
+	if val, ok := _TransactionTypeNameToValueMap[s]; ok {
+		return val, nil
+	}
+
+	if val, ok := _TransactionTypeNameToValueMap[strings.ToLower(s)]; ok {
+		return val, nil
+	}
+	return 0, fmt.Errorf("%s does not belong to TransactionType values", s)
+}
+
+// TransactionTypeValues returns all values of the enum
+func TransactionTypeValues() []TransactionType {
+	return _TransactionTypeValues
+}
+
+// TransactionTypeStrings returns a slice of all String values of the enum
+func TransactionTypeStrings() []string {
+	strs := make([]string, len(_TransactionTypeNames))
+	copy(strs, _TransactionTypeNames)
+	return strs
+}
+
+// IsATransactionType returns "true" if the value is listed in the enum definition. "false" otherwise
+func (i TransactionType) IsATransactionType() bool {
+	for _, v := range _TransactionTypeValues {
+		if i == v {
+			return true
+		}
+	}
+	return false
+}
+
+// MarshalJSON implements the json.Marshaler interface for TransactionType
+func (i TransactionType) MarshalJSON() ([]byte, error) {
+	return json.Marshal(i.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for TransactionType
+func (i *TransactionType) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("TransactionType should be a string, got %s", data)
+	}
+
+	var err error
+	*i, err = TransactionTypeString(s)
+	return err
+}
@@ -6,11 +6,13 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"sync"
 	"sync/atomic"
 	"time"
 
 	"github.com/coder/websocket"
 	"github.com/google/uuid"
+	"golang.org/x/oauth2"
 )
 
 const (
@@ -27,9 +29,31 @@ var (
 // WS provides real time updates from TD Ameritrade's streaming API.
 // See https://developer.tdameritrade.com/content/streaming-data for more information.
 type WS struct {
+	// ctx is the master context passed to NewSocket. connCtx is derived
+	// from it and lives only as long as the current connection
+	ctx            context.Context
 	connCtx        context.Context
 	cancel         context.CancelFunc
 	killedByServer atomic.Bool
+	closed         atomic.Bool
+
+	// everything needed to establish a connection again
+	h            *HTTPClient
+	refreshToken string
+	dialOpts     *websocket.DialOptions
+
+	// loginMu serializes logins, accessToken is the one the session last logged in with.
+	// stopTokenWatch ends watchToken once the socket is closed or gives up reconnecting
+	loginMu        sync.Mutex
+	accessToken    string
+	stopTokenWatch context.CancelFunc
+
+	// reconnect state; backoff is nil when reconnects are disabled
+	backoff          *Backoff
+	reconnecting     atomic.Bool
+	lifecycleHandler func(Lifecycle)
+	subs             subscriptions
+	books            orderBooks
 
 	errHandler func(error)
 
@@ -37,6 +61,10 @@ type WS struct {
 	futureHandler       func(*Future)
 	optionHandler       func(*Option)
 	futureOptionHandler func(*FutureOption)
+	forexHandler        func(*Forex)
+	bookHandler         func(*Book, *OrderBook)
+	screenerHandler     func(*Screener)
+	acctActivityHandler func(*AccountActivity)
 	chartEquityHandler  func(*ChartEquity)
 	chartFutureHandler  func(*ChartFuture)
 
@@ -45,13 +73,21 @@ type WS struct {
 
 	logger *slog.Logger
 	fm     fanoutMutexInterface
-	ws     socketConn
 
-	ConnStatus ConnStatus
-	Server     string
+	// mu guards the connection and what the last login returned, which are
+	// swapped out on reconnect and relogin.
+	// wg tracks the goroutines reading from the current connection
+	mu sync.RWMutex
+	wg sync.WaitGroup
+	ws socketConn
+
+	connStatus ConnStatus
+	server     string
 
 	customerID string
 	correlID   uuid.UUID
+
+	clientChannel, functionID string
 }
 
 type WSOpt func(w *WS)
@@ -95,6 +131,22 @@ func WithFutureOptionHandler(fn func(*FutureOption)) WSOpt {
 	return func(w *WS) { w.futureOptionHandler = fn }
 }
 
+// Handler that will pass forex data back to this function in a goroutine for processing
+func WithForexHandler(fn func(*Forex)) WSOpt { return func(w *WS) { w.forexHandler = fn } }
+
+// Handler that will pass NYSE, Nasdaq and options book snapshots back to this function in a goroutine
+// for processing, along with the OrderBook for that symbol which already has the snapshot applied
+func WithBookHandler(fn func(*Book, *OrderBook)) WSOpt { return func(w *WS) { w.bookHandler = fn } }
+
+// Handler that will pass equity and option screener data back to this function in a goroutine for processing
+func WithScreenerHandler(fn func(*Screener)) WSOpt { return func(w *WS) { w.screenerHandler = fn } }
+
+// Handler that will pass account activity (order fills, cancels, rejects, etc.) back to this function
+// in a goroutine for processing
+func WithAccountActivityHandler(fn func(*AccountActivity)) WSOpt {
+	return func(w *WS) { w.acctActivityHandler = fn }
+}
+
 // Handler that will pass chart equity data back to this function in a goroutine for processing
 func WithChartEquityHandler(fn func(*ChartEquity)) WSOpt {
 	return func(w *WS) { w.chartEquityHandler = fn }
@@ -114,68 +166,128 @@ func NewSocket(ctx context.Context, opts *websocket.DialOptions, h *HTTPClient,
 		return nil, ErrMissingHTTPClient
 	}
 
-	t, err := h.Authenticate(ctx, refreshToken)
-	if err != nil {
-		return nil, err
-	}
-
-	ctx, cancel := context.WithCancel(ctx)
 	s := &WS{
-		logger:     slog.New(slog.DiscardHandler),
-		fm:         &fanoutMutex{timeout: DefaultWSTimeout},
-		pingEvery:  DefaultPingEvery,
-		connCtx:    ctx,
-		cancel:     cancel,
-		errHandler: func(err error) {},
+		ctx:          ctx,
+		h:            h,
+		refreshToken: refreshToken,
+		dialOpts:     opts,
+		logger:       slog.New(slog.DiscardHandler),
+		fm:           &fanoutMutex{timeout: DefaultWSTimeout},
+		pingEvery:    DefaultPingEvery,
+		errHandler:   func(err error) {},
 	}
 
 	for _, v := range wsOpts {
 		v(s)
 	}
 
-	s.logger.InfoContext(ctx, "fetching user preferences")
-	prefs, err := h.GetUserPreference(ctx)
-	if err != nil {
-		s.logger.ErrorContext(ctx, "failed fetching user prefs", "err", err)
+	if err := s.connect(); err != nil {
 		return nil, err
 	}
 
+	watchCtx, cancel := context.WithCancel(ctx)
+	s.stopTokenWatch = cancel
+	go s.watchToken(watchCtx)
+
+	return s, nil
+}
+
+// connect authenticates, dials the socket and logs in, replacing the connection
+// the socket held before. Any goroutines reading from a previous connection
+// must have exited before this is called
+func (s *WS) connect() (err error) {
+	t, err := s.token()
+	if err != nil {
+		return err
+	}
+
+	if t.RefreshToken != "" {
+		s.refreshToken = t.RefreshToken
+	}
+
+	s.logger.InfoContext(s.ctx, "fetching user preferences")
+	prefs, err := s.h.GetUserPreference(s.ctx)
+	if err != nil {
+		s.logger.ErrorContext(s.ctx, "failed fetching user prefs", "err", err)
+		return err
+	}
+
 	if len(prefs.StreamerInfo) == 0 {
-		s.logger.ErrorContext(ctx, "user prefs is blank. This is needed to connect to the socket URL", "resp", prefs)
-		return nil, fmt.Errorf("can't connect: no stream info returned. Resp: %+v", prefs)
+		s.logger.ErrorContext(s.ctx, "user prefs is blank. This is needed to connect to the socket URL", "resp", prefs)
+		return fmt.Errorf("can't connect: no stream info returned. Resp: %+v", prefs)
 	}
 
 	i := prefs.StreamerInfo[0]
-	s.customerID = i.SchwabClientCustomerId
-	s.correlID = i.SchwabClientCorrelId
 
-	s.ws, _, err = websocket.Dial(ctx, i.StreamerSocketURL, opts)
+	ctx, cancel := context.WithCancel(s.ctx)
+	conn, _, err := websocket.Dial(ctx, i.StreamerSocketURL, s.dialOpts)
 	if err != nil {
-		s.logger.ErrorContext(ctx, "failed dialing websocket", "err", err, "options", opts)
-		return nil, err
+		cancel()
+		s.logger.ErrorContext(ctx, "failed dialing websocket", "err", err, "options", s.dialOpts)
+		return err
 	}
 
+	s.mu.Lock()
+	s.connCtx, s.cancel, s.ws = ctx, cancel, conn
+	s.customerID = i.SchwabClientCustomerId
+	s.correlID = i.SchwabClientCorrelId
+	s.clientChannel, s.functionID = i.SchwabClientChannel, i.SchwabClientFunctionId
+	s.mu.Unlock()
+	s.killedByServer.Store(false)
+
+	s.wg.Add(1)
 	go s.keepalive()
 	defer func() {
 		if err != nil {
 			cancel()
-			s.ws.Close(websocket.StatusInternalError, "failed setup of client")
+			conn.Close(websocket.StatusInternalError, "failed setup of client")
+			s.wg.Wait()
 		}
 	}()
 
+	s.loginMu.Lock()
+	defer s.loginMu.Unlock()
+
 	resp, err := s.login(ctx, t.AccessToken, i.SchwabClientChannel, i.SchwabClientFunctionId)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	s.logger.InfoContext(ctx, "login successful", "type", resp.ConnStatus, "server", resp.Server)
-	s.ConnStatus = resp.ConnStatus
-	s.Server = resp.Server
+	s.accessToken = t.AccessToken
+	s.loggedIn(resp)
+	return nil
+}
 
-	return s, nil
+// token is the token to log in with. Reconnects reuse the HTTPClient's token source,
+// which refreshes on its own, instead of authenticating from scratch every attempt
+func (s *WS) token() (oauth2.Token, error) {
+	s.loginMu.Lock()
+	loggedIn := s.accessToken != ""
+	s.loginMu.Unlock()
+
+	if loggedIn {
+		t, err := s.h.Token()
+		if !errors.Is(err, ErrNotAuthenticated) {
+			return t, err
+		}
+	}
+
+	return s.h.Authenticate(s.ctx, s.refreshToken)
 }
 
+// genericReq sends a command and records it in the registry once it's acknowledged.
+// Commands on the same service are sent one at a time, so they're recorded in the
+// order the server applied them
 func (s *WS) genericReq(ctx context.Context, svc service, cmd command, params any) (*WSResp, error) {
+	unlock := s.subs.lock(svc)
+	defer unlock()
+
+	return s.send(ctx, svc, cmd, params)
+}
+
+// send is genericReq without taking the service's lock, for callers that hold it
+func (s *WS) send(ctx context.Context, svc service, cmd command, params any) (*WSResp, error) {
 	req, err := s.do(ctx, svc, cmd, params)
 	if err != nil {
 		return nil, err
@@ -192,18 +304,26 @@ func (s *WS) genericReq(ctx context.Context, svc service, cmd command, params an
 		return nil, err
 	}
 
+	if w.Code.succeeded(cmd) {
+		s.subs.apply(svc, cmd, params)
+	}
+
 	return w, nil
 }
 
 func (s *WS) do(ctx context.Context, svc service, cmd command, params any) (*socketReq, error) {
+	s.mu.RLock()
+	conn, customerID, correlID := s.ws, s.customerID, s.correlID
+	s.mu.RUnlock()
+
 	r := s.fm.request()
 
 	payload := streamRequest{
 		ID:                     r.id,
 		Service:                svc,
 		Command:                cmd,
-		SchwabClientCustomerId: s.customerID,
-		SchwabClientCorrelId:   s.correlID,
+		SchwabClientCustomerId: customerID,
+		SchwabClientCorrelId:   correlID,
 		Parameters:             params,
 	}
 
@@ -214,7 +334,7 @@ func (s *WS) do(ctx context.Context, svc service, cmd command, params any) (*soc
 	}
 
 	l := s.logger.With("payload", payload)
-	if err = s.ws.Write(ctx, websocket.MessageText, buf); err != nil {
+	if err = conn.Write(ctx, websocket.MessageText, buf); err != nil {
 		l.ErrorContext(ctx, "failed writing payload", "err", err)
 		return nil, err
 	}
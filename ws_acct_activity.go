@@ -0,0 +1,192 @@
+package td
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+)
+
+// Schwab requires a key for the subscription but ignores what it is
+const accountActivityKey = "Account Activity"
+
+//go:generate enumer -type AccountActivityField -trimprefix AccountActivityField
+type AccountActivityField byte
+
+const (
+	AccountActivityFieldSubscriptionKey AccountActivityField = iota // Key passed during subscription
+	AccountActivityFieldAccount                                     // Account number the activity occurred on
+	AccountActivityFieldMessageType                                 // Type of message, see AccountActivityKind
+	AccountActivityFieldMessageData                                 // JSON payload describing the activity
+)
+
+//go:generate enumer -type AccountActivityKind -trimprefix AccountActivityKind
+type AccountActivityKind byte
+
+const (
+	AccountActivityKindUnspecified        AccountActivityKind = iota
+	AccountActivityKindSubscribed                             // Acknowledges the subscription, sent once
+	AccountActivityKindOrderCreated                           // Order was received
+	AccountActivityKindOrderAccepted                          // Order was accepted and is working
+	AccountActivityKindOrderRejected                          // Order was rejected
+	AccountActivityKindExecutionRequested                     // Order was routed for execution
+	AccountActivityKindExecution                              // A fill against the order that leaves nothing, or an unknown quantity, to fill
+	AccountActivityKindPartiallyFilled                        // A fill against the order with quantity still left to fill, see LeavesQuantity
+	AccountActivityKindFilled                                 // Order is completely filled
+	AccountActivityKindCancelRequested                        // Cancel request was received
+	AccountActivityKindCanceled                               // Order is out: canceled or expired
+	AccountActivityKindReplaceRequested                       // Replace request was received
+	AccountActivityKindReplaced                               // Replace request was accepted
+	AccountActivityKindUnknown                                // A message type this package doesn't know about; check Type
+)
+
+func newAccountActivityKind(s string) AccountActivityKind {
+	switch s {
+	case "SUBSCRIBED":
+		return AccountActivityKindSubscribed
+	case "OrderCreated":
+		return AccountActivityKindOrderCreated
+	case "OrderAccepted":
+		return AccountActivityKindOrderAccepted
+	case "OrderRejected":
+		return AccountActivityKindOrderRejected
+	case "ExecutionRequested", "ExecutionRequestCreated", "ExecutionRequestCompleted":
+		return AccountActivityKindExecutionRequested
+	case "ExecutionCreated":
+		return AccountActivityKindExecution
+	case "OrderFillCompleted":
+		return AccountActivityKindFilled
+	case "CancelAccepted", "CancelRequested":
+		return AccountActivityKindCancelRequested
+	case "OrderUROutCompleted":
+		return AccountActivityKindCanceled
+	case "ChangeCreated":
+		return AccountActivityKindReplaceRequested
+	case "ChangeAccepted":
+		return AccountActivityKindReplaced
+	default:
+		return AccountActivityKindUnknown
+	}
+}
+
+// AccountActivity is a single event on an account, such as an order fill
+type AccountActivity struct {
+	Sequence int
+	Key      string // Key passed during subscription
+	Account  string // Account number the activity occurred on
+	Kind     AccountActivityKind
+	Type     string // Message type exactly as Schwab sent it
+
+	// Pulled out of the nested payload when present
+	OrderID string // SchwabOrderID
+	Event   string // Specific event type, e.g. ExecutionCreatedEventEquity
+
+	// Set on executions, from the event nested under Event
+	Quantity       float64 // Filled by this execution
+	LeavesQuantity float64 // Left to fill on the order after this execution
+
+	// The full nested payload. Schwab doesn't document its schema, so
+	// anything not decoded above can be read from here
+	Data json.RawMessage
+}
+
+func (a *AccountActivity) UnmarshalJSON(b []byte) error {
+	type activity struct {
+		Sequence int    `json:"seq"`
+		Key      string `json:"key"`
+		Account  string `json:"1"`
+		Type     string `json:"2"`
+		Data     string `json:"3"` // JSON wrapped in a string
+	}
+
+	var x activity
+	if err := json.Unmarshal(b, &x); err != nil {
+		return err
+	}
+
+	*a = AccountActivity{
+		Sequence: x.Sequence,
+		Key:      x.Key,
+		Account:  x.Account,
+		Kind:     newAccountActivityKind(x.Type),
+		Type:     x.Type,
+	}
+
+	// the SUBSCRIBED message and some others send plain text here
+	data := strings.TrimSpace(x.Data)
+	if !strings.HasPrefix(data, "{") {
+		return nil
+	}
+
+	type payload struct {
+		SchwabOrderID string `json:"SchwabOrderID"`
+		AccountNumber string `json:"AccountNumber"`
+		BaseEvent     struct {
+			EventType string `json:"EventType"`
+		} `json:"BaseEvent"`
+	}
+
+	var p payload
+	if err := json.Unmarshal([]byte(data), &p); err != nil {
+		return err
+	}
+
+	a.Data = json.RawMessage(data)
+	a.OrderID = p.SchwabOrderID
+	a.Event = p.BaseEvent.EventType
+	if a.Account == "" {
+		a.Account = p.AccountNumber
+	}
+
+	if a.Kind != AccountActivityKindExecution {
+		return nil
+	}
+
+	// the event itself sits under its own name, e.g.
+	// BaseEvent.ExecutionCreatedEventEquity, with quantities sent as strings
+	var nested struct {
+		BaseEvent map[string]json.RawMessage `json:"BaseEvent"`
+	}
+
+	if err := json.Unmarshal([]byte(data), &nested); err != nil {
+		return err
+	}
+
+	var execution struct {
+		ExecutionQuantity json.Number `json:"ExecutionQuantity"`
+		LeavesQuantity    json.Number `json:"LeavesQuantity"`
+	}
+
+	if raw, ok := nested.BaseEvent[a.Event]; !ok || json.Unmarshal(raw, &execution) != nil {
+		return nil // undocumented, so don't fail on a shape we haven't seen
+	}
+
+	a.Quantity, _ = execution.ExecutionQuantity.Float64()
+	a.LeavesQuantity, _ = execution.LeavesQuantity.Float64()
+	if a.LeavesQuantity > 0 {
+		a.Kind = AccountActivityKindPartiallyFilled
+	}
+
+	return nil
+}
+
+type accountActivityReq struct{}
+
+func (accountActivityReq) MarshalJSON() ([]byte, error) {
+	return json.Marshal(subscribeRequest{Keys: accountActivityKey, Fields: "0,1,2,3"})
+}
+
+func (accountActivityReq) registryKeys() []registryKey {
+	return []registryKey{{wire: accountActivityKey, id: accountActivityKey}}
+}
+
+func (accountActivityReq) registryFields() []int { return []int{0, 1, 2, 3} }
+
+// Subscribe to activity on every account the user has: order creation, fills, cancels, rejects.
+// Events are passed to the handler in WithAccountActivityHandler
+func (s *WS) SetAccountActivitySubscription(ctx context.Context) (*WSResp, error) {
+	return s.genericReq(ctx, serviceAcctActivity, commandSubs, accountActivityReq{})
+}
+
+func (s *WS) UnsubAccountActivitySubscription(ctx context.Context) (*WSResp, error) {
+	return s.genericReq(ctx, serviceAcctActivity, commandUnsubs, accountActivityReq{})
+}
@@ -0,0 +1,107 @@
+package td
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestAccountActivityUnmarshal(mainTest *testing.T) {
+	fill := `{"SchwabOrderID":"1002","AccountNumber":"12345678","BaseEvent":{"EventType":"ExecutionCreatedEventEquity","ExecutionCreatedEventEquity":{"EventType":"ExecutionCreated"}}}`
+	partial := `{"SchwabOrderID":"1003","AccountNumber":"12345678","BaseEvent":{"EventType":"ExecutionCreatedEventEquity","ExecutionCreatedEventEquity":{"EventType":"ExecutionCreated","ExecutionQuantity":"40","LeavesQuantity":"60"}}}`
+	last := `{"SchwabOrderID":"1003","AccountNumber":"12345678","BaseEvent":{"EventType":"ExecutionCreatedEventEquity","ExecutionCreatedEventEquity":{"EventType":"ExecutionCreated","ExecutionQuantity":60,"LeavesQuantity":0}}}`
+	wrap := func(seq int, data string) string {
+		buf, _ := json.Marshal(data)
+		return fmt.Sprintf(`{"seq":%d,"key":"Account Activity","1":"12345678","2":"ExecutionCreated","3":%s}`, seq, buf)
+	}
+
+	testCases := []struct {
+		name     string
+		arg      string
+		expected AccountActivity
+	}{
+		{
+			name: "subscribed",
+			arg:  `{"seq":0,"key":"Account Activity","1":"","2":"SUBSCRIBED","3":""}`,
+			expected: AccountActivity{
+				Key:  "Account Activity",
+				Kind: AccountActivityKindSubscribed,
+				Type: "SUBSCRIBED",
+			},
+		},
+		{
+			name: "execution with nested payload",
+			arg: func() string {
+				buf, _ := json.Marshal(fill)
+				return `{"seq":4,"key":"Account Activity","1":"12345678","2":"ExecutionCreated","3":` + string(buf) + `}`
+			}(),
+			expected: AccountActivity{
+				Sequence: 4,
+				Key:      "Account Activity",
+				Account:  "12345678",
+				Kind:     AccountActivityKindExecution,
+				Type:     "ExecutionCreated",
+				OrderID:  "1002",
+				Event:    "ExecutionCreatedEventEquity",
+				Data:     json.RawMessage(fill),
+			},
+		},
+		{
+			name: "execution leaving quantity to fill is a partial fill",
+			arg:  wrap(5, partial),
+			expected: AccountActivity{
+				Sequence:       5,
+				Key:            "Account Activity",
+				Account:        "12345678",
+				Kind:           AccountActivityKindPartiallyFilled,
+				Type:           "ExecutionCreated",
+				OrderID:        "1003",
+				Event:          "ExecutionCreatedEventEquity",
+				Quantity:       40,
+				LeavesQuantity: 60,
+				Data:           json.RawMessage(partial),
+			},
+		},
+		{
+			name: "execution that completes the order",
+			arg:  wrap(6, last),
+			expected: AccountActivity{
+				Sequence: 6,
+				Key:      "Account Activity",
+				Account:  "12345678",
+				Kind:     AccountActivityKindExecution,
+				Type:     "ExecutionCreated",
+				OrderID:  "1003",
+				Event:    "ExecutionCreatedEventEquity",
+				Quantity: 60,
+				Data:     json.RawMessage(last),
+			},
+		},
+		{
+			name: "unknown message types are kept",
+			arg:  `{"seq":9,"key":"Account Activity","1":"12345678","2":"SomethingNew","3":"text"}`,
+			expected: AccountActivity{
+				Sequence: 9,
+				Key:      "Account Activity",
+				Account:  "12345678",
+				Kind:     AccountActivityKindUnknown,
+				Type:     "SomethingNew",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		mainTest.Run(tc.name, func(tt *testing.T) {
+			var x AccountActivity
+			if err := json.Unmarshal([]byte(tc.arg), &x); err != nil {
+				tt.Errorf("test should not fail, got %s", err)
+				return
+			}
+
+			if !reflect.DeepEqual(x, tc.expected) {
+				tt.Errorf("want %+v\ngot  %+v", tc.expected, x)
+			}
+		})
+	}
+}
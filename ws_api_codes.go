@@ -71,6 +71,25 @@ const (
 	WSRespCodeUnknown WSRespCode = math.MaxUint8
 )
 
+// succeeded reports whether the code acknowledges cmd. Schwab sends either a
+// plain success or the command-specific success code depending on the service
+func (w WSRespCode) succeeded(cmd command) bool {
+	switch w {
+	case WSRespCodeSuccess:
+		return true
+	case WSRespCodeSucceededCommandSubs:
+		return cmd == commandSubs
+	case WSRespCodeSucceededCommandUnsubs:
+		return cmd == commandUnsubs
+	case WSRespCodeSucceededCommandAdd:
+		return cmd == commandAdd
+	case WSRespCodeSucceededCommandView:
+		return cmd == commandView
+	default:
+		return false
+	}
+}
+
 func (w *WSRespCode) UnmarshalJSON(b []byte) error {
 	var x uint8
 	if err := json.Unmarshal(b, &x); err != nil {
@@ -0,0 +1,429 @@
+package td
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+var ErrInvalidBookType = errors.New("invalid book type, must be one of NYSE, Nasdaq, Options")
+
+//go:generate enumer -type BookType -trimprefix BookType
+type BookType byte
+
+const (
+	BookTypeUnspecified BookType = iota
+	BookTypeNyse
+	BookTypeNasdaq
+	BookTypeOptions
+)
+
+func (b BookType) service() (service, error) {
+	switch b {
+	case BookTypeNyse:
+		return serviceNyseBook, nil
+	case BookTypeNasdaq:
+		return serviceNasdaqBook, nil
+	case BookTypeOptions:
+		return serviceOptionsBook, nil
+	default:
+		return serviceUnspecified, fmt.Errorf("%w: got %s", ErrInvalidBookType, b)
+	}
+}
+
+func bookType(s service) BookType {
+	switch s {
+	case serviceNyseBook:
+		return BookTypeNyse
+	case serviceNasdaqBook:
+		return BookTypeNasdaq
+	case serviceOptionsBook:
+		return BookTypeOptions
+	default:
+		return BookTypeUnspecified
+	}
+}
+
+//go:generate enumer -type BookField -trimprefix BookField
+type BookField byte
+
+const (
+	BookFieldSymbol             BookField = iota // Ticker symbol in upper case
+	BookFieldMarketSnapshotTime                  // Milliseconds since epoch
+	BookFieldBidSideLevels                       // Price levels on the bid side
+	BookFieldAskSideLevels                       // Price levels on the ask side
+)
+
+// A single market maker's quote at a price level
+type MarketMaker struct {
+	ID        string
+	Size      int64
+	QuoteTime time.Time
+}
+
+func (m *MarketMaker) UnmarshalJSON(b []byte) error {
+	type marketMaker struct {
+		ID        string `json:"0"`
+		Size      int64  `json:"1"`
+		QuoteTime int64  `json:"2"` // milliseconds since epoch
+	}
+
+	var x marketMaker
+	if err := json.Unmarshal(b, &x); err != nil {
+		return err
+	}
+
+	*m = MarketMaker{ID: x.ID, Size: x.Size, QuoteTime: time.UnixMilli(x.QuoteTime)}
+	return nil
+}
+
+// Every quote at a single price on one side of the book
+type PriceLevel struct {
+	Price            float64
+	Size             int64 // Aggregate size of every market maker at this price
+	MarketMakerCount int
+	MarketMakers     []MarketMaker
+}
+
+func (p *PriceLevel) UnmarshalJSON(b []byte) error {
+	type priceLevel struct {
+		Price            float64       `json:"0"`
+		Size             int64         `json:"1"`
+		MarketMakerCount int           `json:"2"`
+		MarketMakers     []MarketMaker `json:"3"`
+	}
+
+	var x priceLevel
+	if err := json.Unmarshal(b, &x); err != nil {
+		return err
+	}
+
+	*p = PriceLevel(x)
+	return nil
+}
+
+// Book is a single level two snapshot as sent by the server
+type Book struct {
+	Type   BookType
+	Symbol string
+	Time   time.Time // Market snapshot time
+	Bids   []PriceLevel
+	Asks   []PriceLevel
+
+	// updates only carry the sides that changed
+	hasBids, hasAsks bool
+}
+
+func (o *Book) UnmarshalJSON(b []byte) error {
+	type book struct {
+		Key  string          `json:"key"`
+		Time int64           `json:"1"` // milliseconds since epoch
+		Bids json.RawMessage `json:"2"`
+		Asks json.RawMessage `json:"3"`
+	}
+
+	var x book
+	if err := json.Unmarshal(b, &x); err != nil {
+		return err
+	}
+
+	*o = Book{Symbol: x.Key, Time: time.UnixMilli(x.Time)}
+	if o.hasBids = len(x.Bids) > 0; o.hasBids {
+		if err := json.Unmarshal(x.Bids, &o.Bids); err != nil {
+			return fmt.Errorf("failed unmarshal of bid side levels: %w", err)
+		}
+	}
+
+	if o.hasAsks = len(x.Asks) > 0; o.hasAsks {
+		if err := json.Unmarshal(x.Asks, &o.Asks); err != nil {
+			return fmt.Errorf("failed unmarshal of ask side levels: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// OrderBook is the level two book for a single symbol, kept current by
+// applying every snapshot the socket receives. It's safe for concurrent use
+type OrderBook struct {
+	Type   BookType
+	Symbol string
+
+	mu   sync.RWMutex
+	time time.Time
+	bids []PriceLevel // best (highest) first
+	asks []PriceLevel // best (lowest) first
+}
+
+func (o *OrderBook) apply(b *Book) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if b.Time.Before(o.time) {
+		return // stale
+	}
+
+	o.time = b.Time
+	if b.hasBids {
+		o.bids = slices.SortedFunc(slices.Values(b.Bids), func(a, b PriceLevel) int {
+			return compareFloat(b.Price, a.Price)
+		})
+	}
+
+	if b.hasAsks {
+		o.asks = slices.SortedFunc(slices.Values(b.Asks), func(a, b PriceLevel) int {
+			return compareFloat(a.Price, b.Price)
+		})
+	}
+}
+
+func compareFloat(a, b float64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// Time of the last snapshot applied
+func (o *OrderBook) Time() time.Time {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+
+	return o.time
+}
+
+// Bids returns the top n bid levels, best first. n <= 0 returns every level
+func (o *OrderBook) Bids(n int) []PriceLevel {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+
+	return topN(o.bids, n)
+}
+
+// Asks returns the top n ask levels, best first. n <= 0 returns every level
+func (o *OrderBook) Asks(n int) []PriceLevel {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+
+	return topN(o.asks, n)
+}
+
+// BestBid returns the highest bid, if there is one
+func (o *OrderBook) BestBid() (PriceLevel, bool) {
+	if x := o.Bids(1); len(x) > 0 {
+		return x[0], true
+	}
+
+	return PriceLevel{}, false
+}
+
+// BestAsk returns the lowest ask, if there is one
+func (o *OrderBook) BestAsk() (PriceLevel, bool) {
+	if x := o.Asks(1); len(x) > 0 {
+		return x[0], true
+	}
+
+	return PriceLevel{}, false
+}
+
+func topN(levels []PriceLevel, n int) []PriceLevel {
+	if n <= 0 || n > len(levels) {
+		n = len(levels)
+	}
+
+	return slices.Clone(levels[:n])
+}
+
+type bookKey struct {
+	t      BookType
+	symbol string
+}
+
+type orderBooks struct {
+	mu    sync.RWMutex
+	books map[bookKey]*OrderBook
+}
+
+func (o *orderBooks) get(t BookType, symbol string) *OrderBook {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+
+	return o.books[bookKey{t, symbol}]
+}
+
+func (o *orderBooks) apply(b *Book) *OrderBook {
+	k := bookKey{b.Type, b.Symbol}
+
+	o.mu.Lock()
+	if o.books == nil {
+		o.books = map[bookKey]*OrderBook{}
+	}
+
+	ob, ok := o.books[k]
+	if !ok {
+		ob = &OrderBook{Type: b.Type, Symbol: b.Symbol}
+		o.books[k] = ob
+	}
+	o.mu.Unlock()
+
+	ob.apply(b)
+	return ob
+}
+
+func (o *orderBooks) remove(t BookType, symbols ...string) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	for _, v := range symbols {
+		delete(o.books, bookKey{t, v})
+	}
+}
+
+// OrderBook returns the book being maintained for a symbol, or nil if no
+// snapshot for it has been received yet
+func (s *WS) OrderBook(t BookType, symbol string) *OrderBook { return s.books.get(t, symbol) }
+
+// handleBooks applies the snapshots in order before any handler sees them,
+// so the OrderBook is never behind the message passed to the handler
+func (s *WS) handleBooks(data dataResp) {
+	var x []*Book
+	if err := json.Unmarshal(data.Content, &x); err != nil {
+		s.logger.Error("failed unmarshal into correct response type", "raw", data, "err", err)
+		go s.errHandler(err)
+		return
+	}
+
+	t := bookType(data.Service)
+	for _, v := range x {
+		v.Type = t
+		ob := s.books.apply(v)
+		if s.bookHandler != nil {
+			go s.bookHandler(v, ob)
+		}
+	}
+}
+
+// Symbols in upper case. For the options book, use OptionID.String()
+type BookReq struct {
+	Symbols []string
+	Fields  []BookField
+}
+
+func (b *BookReq) fields() (string, error) {
+	var sb strings.Builder
+	n := len(b.Fields) - 1
+	for i, v := range b.Fields {
+		if !v.IsABookField() {
+			return "", fmt.Errorf("%s is not a book field", v)
+		}
+
+		sb.WriteString(fmt.Sprintf("%d", int(v)))
+		if i != n {
+			sb.WriteRune(',')
+		}
+	}
+
+	return sb.String(), nil
+}
+
+func (b *BookReq) MarshalJSON() ([]byte, error) {
+	s := subscribeRequest{}
+	if len(b.Fields) > 0 {
+		var err error
+		if s.Fields, err = b.fields(); err != nil {
+			return nil, err
+		}
+	}
+
+	for i, v := range b.Symbols {
+		if v == "" {
+			return nil, fmt.Errorf("error at symbol index %d: %w", i, ErrMissingSymbol)
+		}
+	}
+
+	s.Keys = strings.Join(b.Symbols, ",")
+	return json.Marshal(s)
+}
+
+func (b *BookReq) registryKeys() []registryKey { return stringKeys(b.Symbols) }
+func (b *BookReq) registryFields() []int       { return fieldInts(b.Fields) }
+
+// This uses the SUBS command to subscribe to the book of type t. Using this command, you reset your subscriptions
+// for that book to include only this set of symbols and fields
+func (s *WS) SetBookSubscription(ctx context.Context, t BookType, subs *BookReq) (*WSResp, error) {
+	svc, err := t.service()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(subs.Fields) == 0 {
+		return nil, ErrMissingField
+	}
+
+	if len(subs.Symbols) == 0 {
+		return nil, ErrMissingSymbol
+	}
+
+	return s.genericReq(ctx, svc, commandSubs, subs)
+}
+
+// This uses the ADD command to add additional symbols to the subscription list, if any exist.
+// If none exist, then this will create them. If you are creating subscriptions for the first time,
+// you will need to provide a value for subs.Fields, otherwise it's not required
+func (s *WS) AddBookSubscription(ctx context.Context, t BookType, subs *BookReq) (*WSResp, error) {
+	svc, err := t.service()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(subs.Symbols) == 0 {
+		return nil, ErrMissingSymbol
+	}
+
+	return s.genericReq(ctx, svc, commandAdd, subs)
+}
+
+func (s *WS) SetBookSubscriptionView(ctx context.Context, t BookType, fields ...BookField) (*WSResp, error) {
+	svc, err := t.service()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(fields) == 0 {
+		return nil, ErrMissingField
+	}
+
+	return s.genericReq(ctx, svc, commandView, &BookReq{Fields: fields})
+}
+
+// Unsubscribing also drops the OrderBook kept for each symbol
+func (s *WS) UnsubBookSubscription(ctx context.Context, t BookType, symbols ...string) (*WSResp, error) {
+	svc, err := t.service()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(symbols) == 0 {
+		return nil, ErrMissingSymbol
+	}
+
+	resp, err := s.genericReq(ctx, svc, commandUnsubs, &BookReq{Symbols: symbols})
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.Code.succeeded(commandUnsubs) {
+		s.books.remove(t, symbols...)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,93 @@
+package td
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestBookUnmarshal(t *testing.T) {
+	arg := `{"key":"MSFT","1":1742275584551,
+"2":[{"0":380.5,"1":300,"2":2,"3":[{"0":"NSDQ","1":200,"2":1742275584000},{"0":"ARCX","1":100,"2":1742275583000}]},
+	{"0":380.6,"1":100,"2":1,"3":[{"0":"NSDQ","1":100,"2":1742275584000}]}],
+"3":[{"0":380.9,"1":100,"2":1,"3":[{"0":"EDGX","1":100,"2":1742275584100}]}]}`
+
+	want := Book{
+		Symbol: "MSFT",
+		Time:   time.UnixMilli(1742275584551),
+		Bids: []PriceLevel{
+			{Price: 380.5, Size: 300, MarketMakerCount: 2, MarketMakers: []MarketMaker{
+				{ID: "NSDQ", Size: 200, QuoteTime: time.UnixMilli(1742275584000)},
+				{ID: "ARCX", Size: 100, QuoteTime: time.UnixMilli(1742275583000)},
+			}},
+			{Price: 380.6, Size: 100, MarketMakerCount: 1, MarketMakers: []MarketMaker{
+				{ID: "NSDQ", Size: 100, QuoteTime: time.UnixMilli(1742275584000)},
+			}},
+		},
+		Asks: []PriceLevel{
+			{Price: 380.9, Size: 100, MarketMakerCount: 1, MarketMakers: []MarketMaker{
+				{ID: "EDGX", Size: 100, QuoteTime: time.UnixMilli(1742275584100)},
+			}},
+		},
+		hasBids: true,
+		hasAsks: true,
+	}
+
+	var got Book
+	if err := json.Unmarshal([]byte(arg), &got); err != nil {
+		t.Fatalf("should not fail unmarshal: %s", err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("want %+v\ngot  %+v", want, got)
+	}
+}
+
+func TestOrderBookApply(t *testing.T) {
+	var books orderBooks
+	now := time.Now()
+
+	ob := books.apply(&Book{
+		Type:    BookTypeNasdaq,
+		Symbol:  "MSFT",
+		Time:    now,
+		Bids:    []PriceLevel{{Price: 1}, {Price: 3}, {Price: 2}},
+		Asks:    []PriceLevel{{Price: 6}, {Price: 4}, {Price: 5}},
+		hasBids: true,
+		hasAsks: true,
+	})
+
+	if books.get(BookTypeNasdaq, "MSFT") != ob {
+		t.Fatalf("book should be stored by type and symbol")
+	}
+
+	if got := ob.Bids(2); !reflect.DeepEqual(got, []PriceLevel{{Price: 3}, {Price: 2}}) {
+		t.Errorf("bids should be best first, got %+v", got)
+	}
+
+	if got := ob.Asks(0); !reflect.DeepEqual(got, []PriceLevel{{Price: 4}, {Price: 5}, {Price: 6}}) {
+		t.Errorf("asks should be best first, got %+v", got)
+	}
+
+	// an update with only the ask side leaves the bids alone
+	books.apply(&Book{Type: BookTypeNasdaq, Symbol: "MSFT", Time: now.Add(time.Second), Asks: []PriceLevel{{Price: 3.5}}, hasAsks: true})
+	if x, ok := ob.BestAsk(); !ok || x.Price != 3.5 {
+		t.Errorf("best ask should be 3.5, got %+v", x)
+	}
+
+	if x, ok := ob.BestBid(); !ok || x.Price != 3 {
+		t.Errorf("best bid should be untouched at 3, got %+v", x)
+	}
+
+	// stale snapshots are dropped
+	books.apply(&Book{Type: BookTypeNasdaq, Symbol: "MSFT", Time: now, Bids: []PriceLevel{}, hasBids: true})
+	if x, ok := ob.BestBid(); !ok || x.Price != 3 {
+		t.Errorf("stale snapshot should not apply, got %+v", x)
+	}
+
+	books.remove(BookTypeNasdaq, "MSFT")
+	if books.get(BookTypeNasdaq, "MSFT") != nil {
+		t.Errorf("book should be removed")
+	}
+}
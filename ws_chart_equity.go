@@ -112,6 +112,9 @@ func (f *ChartEquityReq) fields() (string, error) {
 	return sb.String(), nil
 }
 
+func (f *ChartEquityReq) registryKeys() []registryKey { return stringKeys(f.Symbols) }
+func (f *ChartEquityReq) registryFields() []int       { return fieldInts(f.Fields) }
+
 // This uses the SUBS command to subscribe. Using this command, you reset your subscriptions to include only this
 // set of symbols and fields
 func (s *WS) SetChartEquitySubscription(ctx context.Context, subs *ChartEquityReq) (*WSResp, error) {
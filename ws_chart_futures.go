@@ -115,6 +115,9 @@ func (f *ChartFutureReq) fields() (string, error) {
 	return sb.String(), nil
 }
 
+func (f *ChartFutureReq) registryKeys() []registryKey { return stringKeys(f.Symbols) }
+func (f *ChartFutureReq) registryFields() []int       { return fieldInts(f.Fields) }
+
 // This uses the SUBS command to subscribe. Using this command, you reset your subscriptions to include only this
 // set of symbols and fields
 func (s *WS) SetChartFutureSubscription(ctx context.Context, subs *ChartFutureReq) (*WSResp, error) {
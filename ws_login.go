@@ -22,6 +22,26 @@ type LoginResp struct {
 	ConnStatus
 }
 
+// Whether the last login was as a professional or non-professional user
+func (s *WS) ConnStatus() ConnStatus {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.connStatus
+}
+
+// The server the last login landed on
+func (s *WS) Server() string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.server
+}
+
+func (s *WS) loggedIn(resp LoginResp) {
+	s.mu.Lock()
+	s.connStatus, s.server = resp.ConnStatus, resp.Server
+	s.mu.Unlock()
+}
+
 // Login. Client channel and functionID can be found from user preferences endpoint
 func (s *WS) login(ctx context.Context, accessToken, clientChannel, functionID string) (loginResp LoginResp, err error) {
 	req, err := s.do(ctx, serviceAdmin, commandLogin, map[string]any{
@@ -71,8 +91,22 @@ func (s *WS) login(ctx context.Context, accessToken, clientChannel, functionID s
 	return loginResp, nil
 }
 
-// Close will attempt a logout with the WS API, then finally close the socket conn
+// Close will attempt a logout with the WS API, then finally close the socket conn.
+// A socket closed this way will not reconnect
 func (s *WS) Close(ctx context.Context) error {
+	s.closed.Store(true)
+	if s.stopTokenWatch != nil {
+		s.stopTokenWatch()
+	}
+
+	return s.logout(ctx)
+}
+
+func (s *WS) logout(ctx context.Context) error {
+	s.mu.RLock()
+	conn := s.ws
+	s.mu.RUnlock()
+
 	req, err := s.do(ctx, serviceAdmin, commandLogout, nil)
 	if err != nil {
 		return err
@@ -90,14 +124,14 @@ func (s *WS) Close(ctx context.Context) error {
 	}
 
 	if a.Code == WSRespCodeSuccess {
-		if err := s.ws.Close(websocket.StatusNormalClosure, "user initiated close"); err != nil {
+		if err := conn.Close(websocket.StatusNormalClosure, "user initiated close"); err != nil {
 			s.logger.ErrorContext(ctx, "failed normal WS closure", "err", err)
 		}
 
 		return err
 	}
 
-	return errors.Join(err, s.ws.CloseNow())
+	return errors.Join(err, conn.CloseNow())
 }
 
 func seekLoginResp(s string) string {
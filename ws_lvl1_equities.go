@@ -131,6 +131,11 @@ const (
 	AssetTypeMutualFund
 	AssetTypeOption
 	AssetTypeUnknown
+	AssetTypeCashEquivalent
+	AssetTypeFixedIncome
+	AssetTypeCurrency
+	AssetTypeCollectiveInvestment
+	AssetTypeProduct
 )
 
 //go:generate enumer -type AssetSubtype -trimprefix AssetSubtype -json -transform snake-upper
@@ -560,6 +565,9 @@ func (e *EquityReq) MarshalJSON() ([]byte, error) {
 	return json.Marshal(s)
 }
 
+func (e *EquityReq) registryKeys() []registryKey { return stringKeys(e.Symbols) }
+func (e *EquityReq) registryFields() []int       { return fieldInts(e.Fields) }
+
 // This uses the SUBS command to subscribe to equities. Using this command, you reset your subscriptions to include only this
 // set of symbols and fields
 func (s *WS) SetEquitySubscription(ctx context.Context, subs *EquityReq) (*WSResp, error) {
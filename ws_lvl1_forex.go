@@ -0,0 +1,264 @@
+package td
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+//go:generate enumer -type ForexField -trimprefix ForexField
+type ForexField byte
+
+const (
+	ForexFieldSymbol         ForexField = iota // Currency pair in upper case, e.g. EUR/USD
+	ForexFieldBidPrice                         // Current best bid price
+	ForexFieldAskPrice                         // Current best ask price
+	ForexFieldLastPrice                        // Price at which the last trade was matched
+	ForexFieldBidSize                          // Number of units for bid
+	ForexFieldAskSize                          // Number of units for ask
+	ForexFieldTotalVolume                      // Aggregated shares traded throughout the day
+	ForexFieldLastSize                         // Number of units traded with last trade
+	ForexFieldQuoteTime                        // Time of the last quote in milliseconds since epoch
+	ForexFieldTradeTime                        // Time of the last trade in milliseconds since epoch
+	ForexFieldHighPrice                        // Day's high trade price
+	ForexFieldLowPrice                         // Day's low trade price
+	ForexFieldClosePrice                       // Previous day's closing price
+	ForexFieldExchange                         // Primary "listing" exchange
+	ForexFieldDescription                      // Description of the product
+	ForexFieldOpenPrice                        // Day's open price
+	ForexFieldNetChange                        // LastPrice - ClosePrice
+	ForexFieldPercentChange                    // NetChange / ClosePrice * 100
+	ForexFieldExchangeName                     // Name of exchange
+	ForexFieldDigits                           // Valid decimal points
+	ForexFieldSecurityStatus                   // Trading status of the symbol: Normal, Halted, Closed
+	ForexFieldTick                             // The minimum price movement
+	ForexFieldTickAmount                       // The minimum amount that the price of the market can change
+	ForexFieldProduct                          // Product name
+	ForexFieldTradingHours                     // Trading hours
+	ForexFieldIsTradable                       // Flag to indicate if this forex is tradable
+	ForexFieldMarketMaker                      // Market maker
+	ForexField52WeekHigh                       // Highest price traded in the past 12 months, or 52 weeks
+	ForexField52WeekLow                        // Lowest price traded in the past 12 months, or 52 weeks
+	ForexFieldMark                             // Mark-to-market price
+)
+
+type Forex struct {
+	Symbol         string         // Currency pair in upper case, e.g. EUR/USD
+	BidPrice       float64        // Current best bid price
+	AskPrice       float64        // Current best ask price
+	LastPrice      float64        // Price at which the last trade was matched
+	BidSize        int64          // Number of units for bid
+	AskSize        int64          // Number of units for ask
+	TotalVolume    int64          // Aggregated shares traded throughout the day
+	LastSize       int64          // Number of units traded with last trade
+	QuoteTime      time.Time      // Time of the last quote
+	TradeTime      time.Time      // Time of the last trade
+	HighPrice      float64        // Day's high trade price
+	LowPrice       float64        // Day's low trade price
+	ClosePrice     float64        // Previous day's closing price
+	Exchange       rune           // Primary "listing" exchange
+	Description    string         // Description of the product
+	OpenPrice      float64        // Day's open price
+	NetChange      float64        // LastPrice - ClosePrice
+	PercentChange  float64        // NetChange / ClosePrice * 100
+	ExchangeName   string         // Name of exchange
+	Digits         int            // Valid decimal points
+	SecurityStatus SecurityStatus // Trading status of the symbol
+	Tick           float64        // The minimum price movement
+	TickAmount     float64        // The minimum amount that the price of the market can change
+	Product        string         // Product name
+	TradingHours   string         // Trading hours
+	IsTradable     bool           // Flag to indicate if this forex is tradable
+	MarketMaker    string
+	High52Week     float64 // Highest price traded in the past 12 months, or 52 weeks
+	Low52Week      float64 // Lowest price traded in the past 12 months, or 52 weeks
+	Mark           float64 // Mark-to-market price
+
+	// When true the data is delayed, see the same field on Equity
+	Delayed bool
+}
+
+func (f *Forex) UnmarshalJSON(b []byte) error {
+	type forex struct {
+		Key            string         `json:"key"`
+		Symbol         string         `json:"0"`
+		BidPrice       float64        `json:"1"`
+		AskPrice       float64        `json:"2"`
+		LastPrice      float64        `json:"3"`
+		BidSize        int64          `json:"4"`
+		AskSize        int64          `json:"5"`
+		TotalVolume    int64          `json:"6"`
+		LastSize       int64          `json:"7"`
+		QuoteTime      int64          `json:"8"` // milliseconds since epoch
+		TradeTime      int64          `json:"9"` // milliseconds since epoch
+		HighPrice      float64        `json:"10"`
+		LowPrice       float64        `json:"11"`
+		ClosePrice     float64        `json:"12"`
+		Exchange       string         `json:"13"` // char, sent as a string
+		Description    string         `json:"14"`
+		OpenPrice      float64        `json:"15"`
+		NetChange      float64        `json:"16"`
+		PercentChange  float64        `json:"17"`
+		ExchangeName   string         `json:"18"`
+		Digits         int            `json:"19"`
+		SecurityStatus SecurityStatus `json:"20"`
+		Tick           float64        `json:"21"`
+		TickAmount     float64        `json:"22"`
+		Product        string         `json:"23"`
+		TradingHours   string         `json:"24"`
+		IsTradable     bool           `json:"25"`
+		MarketMaker    string         `json:"26"`
+		High52Week     float64        `json:"27"`
+		Low52Week      float64        `json:"28"`
+		Mark           float64        `json:"29"`
+		Delayed        bool           `json:"delayed"`
+	}
+
+	var x forex
+	if err := json.Unmarshal(b, &x); err != nil {
+		return err
+	}
+
+	// symbol is only sent when explicitly requested as a field, the key always is
+	if x.Symbol == "" {
+		x.Symbol = x.Key
+	}
+
+	var exchange rune
+	if r := []rune(x.Exchange); len(r) > 0 {
+		exchange = r[0]
+	}
+
+	*f = Forex{
+		Symbol:         x.Symbol,
+		BidPrice:       x.BidPrice,
+		AskPrice:       x.AskPrice,
+		LastPrice:      x.LastPrice,
+		BidSize:        x.BidSize,
+		AskSize:        x.AskSize,
+		TotalVolume:    x.TotalVolume,
+		LastSize:       x.LastSize,
+		QuoteTime:      time.UnixMilli(x.QuoteTime),
+		TradeTime:      time.UnixMilli(x.TradeTime),
+		HighPrice:      x.HighPrice,
+		LowPrice:       x.LowPrice,
+		ClosePrice:     x.ClosePrice,
+		Exchange:       exchange,
+		Description:    x.Description,
+		OpenPrice:      x.OpenPrice,
+		NetChange:      x.NetChange,
+		PercentChange:  x.PercentChange,
+		ExchangeName:   x.ExchangeName,
+		Digits:         x.Digits,
+		SecurityStatus: x.SecurityStatus,
+		Tick:           x.Tick,
+		TickAmount:     x.TickAmount,
+		Product:        x.Product,
+		TradingHours:   x.TradingHours,
+		IsTradable:     x.IsTradable,
+		MarketMaker:    x.MarketMaker,
+		High52Week:     x.High52Week,
+		Low52Week:      x.Low52Week,
+		Mark:           x.Mark,
+		Delayed:        x.Delayed,
+	}
+	return nil
+}
+
+// Currency pairs in upper case separated by a '/', e.g. EUR/USD, USD/JPY
+type ForexReq struct {
+	Symbols []string
+	Fields  []ForexField
+}
+
+func (f *ForexReq) fields() (string, error) {
+	var sb strings.Builder
+	n := len(f.Fields) - 1
+	for i, v := range f.Fields {
+		if !v.IsAForexField() {
+			return "", fmt.Errorf("%s is not a forex field", v)
+		}
+
+		sb.WriteString(fmt.Sprintf("%d", int(v)))
+		if i != n {
+			sb.WriteRune(',')
+		}
+	}
+
+	return sb.String(), nil
+}
+
+func (f *ForexReq) symbols() (string, error) {
+	for i, v := range f.Symbols {
+		if !strings.Contains(v, "/") {
+			return "", fmt.Errorf("invalid symbol at index %d: currency pairs must be '/' delimited, got %s", i, v)
+		}
+	}
+
+	return strings.Join(f.Symbols, ","), nil
+}
+
+func (f *ForexReq) MarshalJSON() ([]byte, error) {
+	s := subscribeRequest{}
+	if len(f.Fields) > 0 {
+		var err error
+		if s.Fields, err = f.fields(); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(f.Symbols) > 0 {
+		var err error
+		if s.Keys, err = f.symbols(); err != nil {
+			return nil, err
+		}
+	}
+
+	return json.Marshal(s)
+}
+
+func (f *ForexReq) registryKeys() []registryKey { return stringKeys(f.Symbols) }
+func (f *ForexReq) registryFields() []int       { return fieldInts(f.Fields) }
+
+// This uses the SUBS command to subscribe. Using this command, you reset your subscriptions to include only this
+// set of symbols and fields
+func (s *WS) SetForexSubscription(ctx context.Context, subs *ForexReq) (*WSResp, error) {
+	if len(subs.Fields) == 0 {
+		return nil, ErrMissingField
+	}
+
+	if len(subs.Symbols) == 0 {
+		return nil, ErrMissingSymbol
+	}
+
+	return s.genericReq(ctx, serviceLeveloneForex, commandSubs, subs)
+}
+
+// This uses the ADD command to add additional symbols to the subscription list, if any exist.
+// If none exist, then this will create them. If you are creating subscriptions for the first time,
+// you will need to provide a value for subs.Fields, otherwise it's not required
+func (s *WS) AddForexSubscription(ctx context.Context, subs *ForexReq) (*WSResp, error) {
+	if len(subs.Symbols) == 0 {
+		return nil, ErrMissingSymbol
+	}
+
+	return s.genericReq(ctx, serviceLeveloneForex, commandAdd, subs)
+}
+
+func (s *WS) SetForexSubscriptionView(ctx context.Context, fields ...ForexField) (*WSResp, error) {
+	if len(fields) == 0 {
+		return nil, ErrMissingField
+	}
+
+	return s.genericReq(ctx, serviceLeveloneForex, commandView, &ForexReq{Fields: fields})
+}
+
+func (s *WS) UnsubForexSubscription(ctx context.Context, symbols ...string) (*WSResp, error) {
+	if len(symbols) == 0 {
+		return nil, ErrMissingSymbol
+	}
+
+	return s.genericReq(ctx, serviceLeveloneForex, commandUnsubs, &ForexReq{Symbols: symbols})
+}
@@ -0,0 +1,119 @@
+package td
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestForexUnmarshal(mainTest *testing.T) {
+	testCases := []struct {
+		name     string
+		arg      string
+		expected Forex
+	}{
+		{
+			name: "full payload",
+			arg: `{"key":"EUR/USD","delayed":false,"0":"EUR/USD","1":1.0862,"2":1.0864,"3":1.0863,"4":1000000,"5":2000000,
+"6":0,"7":0,"8":1742275584551,"9":1742275584000,"10":1.0901,"11":1.0842,"12":1.0851,"13":"T","14":"Euro/USDollar Spot",
+"15":1.0850,"16":0.0012,"17":0.11,"18":"GFT","19":5,"20":"Normal","21":0.00001,"22":1,"23":"EUR/USD","24":"",
+"25":true,"26":"","27":1.1214,"28":1.0177,"29":1.0863}`,
+			expected: Forex{
+				Symbol:         "EUR/USD",
+				BidPrice:       1.0862,
+				AskPrice:       1.0864,
+				LastPrice:      1.0863,
+				BidSize:        1000000,
+				AskSize:        2000000,
+				QuoteTime:      time.UnixMilli(1742275584551),
+				TradeTime:      time.UnixMilli(1742275584000),
+				HighPrice:      1.0901,
+				LowPrice:       1.0842,
+				ClosePrice:     1.0851,
+				Exchange:       'T',
+				Description:    "Euro/USDollar Spot",
+				OpenPrice:      1.0850,
+				NetChange:      0.0012,
+				PercentChange:  0.11,
+				ExchangeName:   "GFT",
+				Digits:         5,
+				SecurityStatus: SecurityStatusNormal,
+				Tick:           0.00001,
+				TickAmount:     1,
+				Product:        "EUR/USD",
+				IsTradable:     true,
+				High52Week:     1.1214,
+				Low52Week:      1.0177,
+				Mark:           1.0863,
+			},
+		},
+		{
+			name: "partial update falls back to key for the symbol",
+			arg:  `{"key":"USD/JPY","delayed":true,"1":149.21,"2":149.23}`,
+			expected: Forex{
+				Symbol:    "USD/JPY",
+				BidPrice:  149.21,
+				AskPrice:  149.23,
+				QuoteTime: time.UnixMilli(0),
+				TradeTime: time.UnixMilli(0),
+				Delayed:   true,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		mainTest.Run(tc.name, func(tt *testing.T) {
+			var x Forex
+			if err := json.Unmarshal([]byte(tc.arg), &x); err != nil {
+				tt.Errorf("test should not fail, got %s", err)
+				return
+			}
+
+			if !reflect.DeepEqual(x, tc.expected) {
+				tt.Errorf("want %+v\ngot  %+v", tc.expected, x)
+			}
+		})
+	}
+}
+
+func TestForexReqMarshal(mainTest *testing.T) {
+	testCases := []struct {
+		name        string
+		arg         ForexReq
+		expected    string
+		expectedErr bool
+	}{
+		{
+			name:     "keys and fields",
+			arg:      ForexReq{Symbols: []string{"EUR/USD", "USD/JPY"}, Fields: []ForexField{ForexFieldSymbol, ForexFieldMark}},
+			expected: `{"keys":"EUR/USD,USD/JPY","fields":"0,29"}`,
+		},
+		{
+			name:        "rejects symbols that aren't currency pairs",
+			arg:         ForexReq{Symbols: []string{"EURUSD"}},
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		mainTest.Run(tc.name, func(tt *testing.T) {
+			buf, err := json.Marshal(&tc.arg)
+			if tc.expectedErr {
+				if err == nil {
+					tt.Errorf("expected error, got %s", buf)
+				}
+				return
+			}
+
+			if err != nil {
+				tt.Errorf("test should not fail, got %s", err)
+				return
+			}
+
+			if string(buf) != tc.expected {
+				tt.Errorf("want %s, got %s", tc.expected, buf)
+			}
+		})
+	}
+}
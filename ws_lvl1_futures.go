@@ -423,6 +423,9 @@ func (f *FutureReq) fields() (string, error) {
 	return sb.String(), nil
 }
 
+func (f *FutureReq) registryKeys() []registryKey { return stringerKeys(f.Symbols) }
+func (f *FutureReq) registryFields() []int       { return fieldInts(f.Fields) }
+
 // This uses the SUBS command to subscribe. Using this command, you reset your subscriptions to include only this
 // set of symbols and fields
 func (s *WS) SetFutureSubscription(ctx context.Context, subs *FutureReq) (*WSResp, error) {
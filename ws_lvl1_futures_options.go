@@ -274,6 +274,9 @@ func (f *FutureOptionReq) fields() (string, error) {
 	return sb.String(), nil
 }
 
+func (f *FutureOptionReq) registryKeys() []registryKey { return stringerKeys(f.Symbols) }
+func (f *FutureOptionReq) registryFields() []int       { return fieldInts(f.Fields) }
+
 // This uses the SUBS command to subscribe. Using this command, you reset your subscriptions to include only this
 // set of symbols and fields
 func (s *WS) SetFutureOptionSubscription(ctx context.Context, subs *FutureOptionReq) (*WSResp, error) {
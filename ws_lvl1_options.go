@@ -516,6 +516,9 @@ func (o *Option) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
+func (o *OptionReq) registryKeys() []registryKey { return stringerKeys(o.Options) }
+func (o *OptionReq) registryFields() []int       { return fieldInts(o.Fields) }
+
 // This uses the SUBS command to subscribe to equities. Using this command, you reset your subscriptions to include only this
 // set of symbols and fields
 func (s *WS) SetOptionSubscription(ctx context.Context, subs *OptionReq) (*WSResp, error) {
@@ -0,0 +1,180 @@
+package td
+
+import (
+	"fmt"
+	"net"
+	"time"
+)
+
+// DefaultBackoff is a sane reconnect policy for Schwab's routine disconnects:
+// start at one second, double each attempt up to a minute, never give up
+var DefaultBackoff = Backoff{Min: time.Second, Max: time.Minute}
+
+// Backoff controls how long the socket waits between reconnect attempts.
+// The wait starts at Min and doubles every failed attempt until it hits Max
+type Backoff struct {
+	Min, Max time.Duration
+
+	// Give up after this many failed attempts. 0 retries until the
+	// context passed to NewSocket is canceled
+	MaxAttempts uint
+}
+
+func (b Backoff) delay(attempt uint) time.Duration {
+	d := b.Min
+	for i := uint(1); i < attempt && d < b.Max; i++ {
+		d *= 2
+	}
+
+	if b.Max > 0 && d > b.Max {
+		return b.Max
+	}
+
+	return d
+}
+
+//go:generate enumer -type LifecycleEvent -trimprefix LifecycleEvent
+type LifecycleEvent byte
+
+const (
+	LifecycleEventUnspecified     LifecycleEvent = iota
+	LifecycleEventDisconnected                   // the connection dropped; Err holds the cause
+	LifecycleEventReconnecting                   // a reconnect attempt is about to start
+	LifecycleEventReconnected                    // a new connection logged in successfully
+	LifecycleEventResubscribed                   // every tracked subscription was replayed on the new connection
+	LifecycleEventReconnectFailed                // Backoff.MaxAttempts was exhausted; the socket is dead
+)
+
+// Lifecycle is passed to the handler in WithLifecycleHandler every time
+// the connection changes state
+type Lifecycle struct {
+	Event   LifecycleEvent
+	Attempt uint // which reconnect attempt this event belongs to, starting at 1
+	Err     error
+}
+
+// Opt in to automatic reconnects. When the server drops the connection, the
+// socket re-authenticates with the HTTPClient passed to NewSocket, dials again,
+// logs in and replays the last known subscriptions for every service.
+// Errors are still sent to the error handler. A socket closed with Close,
+// or whose context is canceled, never reconnects. A Min of 0 is DefaultBackoff.Min
+// and Max is never less than Min, so attempts can't run back to back
+func WithReconnect(b Backoff) WSOpt {
+	if b.Min <= 0 {
+		b.Min = DefaultBackoff.Min
+	}
+
+	if b.Max < b.Min {
+		b.Max = b.Min
+	}
+
+	return func(w *WS) { w.backoff = &b }
+}
+
+// Every time the connection changes state (see LifecycleEvent) this
+// function is called. By default, this is a no-op
+func WithLifecycleHandler(fn func(Lifecycle)) WSOpt {
+	return func(w *WS) { w.lifecycleHandler = fn }
+}
+
+func (s *WS) lifecycle(l Lifecycle) {
+	if s.lifecycleHandler != nil {
+		s.lifecycleHandler(l)
+	}
+}
+
+func (s *WS) reconnect(cause error) {
+	// the old connection's goroutines must be gone before it gets replaced
+	s.wg.Wait()
+	s.lifecycle(Lifecycle{Event: LifecycleEventDisconnected, Err: cause})
+
+	for attempt := uint(1); ; attempt++ {
+		if n := s.backoff.MaxAttempts; n > 0 && attempt > n {
+			err := fmt.Errorf("%w: gave up reconnecting after %d attempts", net.ErrClosed, n)
+			s.logger.ErrorContext(s.ctx, "reconnect failed", "err", err)
+			s.lifecycle(Lifecycle{Event: LifecycleEventReconnectFailed, Attempt: n, Err: err})
+			s.errHandler(err)
+			if s.stopTokenWatch != nil {
+				s.stopTokenWatch()
+			}
+
+			return
+		}
+
+		select {
+		case <-s.ctx.Done():
+			s.reconnecting.Store(false)
+			return
+		case <-time.After(s.backoff.delay(attempt)):
+		}
+
+		if s.closed.Load() {
+			s.reconnecting.Store(false)
+			return
+		}
+
+		s.logger.InfoContext(s.ctx, "reconnecting", "attempt", attempt)
+		s.lifecycle(Lifecycle{Event: LifecycleEventReconnecting, Attempt: attempt})
+		if err := s.connect(); err != nil {
+			s.logger.ErrorContext(s.ctx, "failed reconnect attempt", "attempt", attempt, "err", err)
+			s.errHandler(err)
+			continue
+		}
+
+		s.lifecycle(Lifecycle{Event: LifecycleEventReconnected, Attempt: attempt})
+		if err := s.resubscribe(); err != nil {
+			s.logger.ErrorContext(s.ctx, "failed replaying subscriptions", "attempt", attempt, "err", err)
+			s.errHandler(err)
+		} else {
+			s.lifecycle(Lifecycle{Event: LifecycleEventResubscribed, Attempt: attempt})
+		}
+
+		// if the new connection died while replaying, the keepalive goroutines
+		// saw a reconnect in progress and left it to us
+		s.mu.RLock()
+		connCtx := s.connCtx
+		s.mu.RUnlock()
+
+		s.reconnecting.Store(false)
+		if connCtx.Err() == nil || !s.reconnecting.CompareAndSwap(false, true) {
+			return
+		}
+
+		s.wg.Wait()
+		attempt = 0
+	}
+}
+
+// resubscribe issues a SUBS for every service with tracked subscriptions. Each
+// service is read under its lock, so commands still in flight from the old
+// connection are recorded before they're replayed, never after
+func (s *WS) resubscribe() error {
+	for svc := range s.subs.snapshot() {
+		if err := s.resubscribeService(svc); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *WS) resubscribeService(svc service) error {
+	unlock := s.subs.lock(svc)
+	defer unlock()
+
+	sub := s.subs.get(svc)
+	if len(sub.keys) == 0 {
+		return nil
+	}
+
+	resp, err := s.send(s.ctx, svc, commandSubs, sub.req())
+	if err != nil {
+		return err
+	}
+
+	if !resp.Code.succeeded(commandSubs) {
+		return resp
+	}
+
+	return nil
+}
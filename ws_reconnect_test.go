@@ -0,0 +1,167 @@
+package td
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/coder/websocket"
+)
+
+func TestBackoffDelay(mainTest *testing.T) {
+	b := Backoff{Min: time.Second, Max: 5 * time.Second}
+	testCases := []struct {
+		attempt  uint
+		expected time.Duration
+	}{
+		{1, time.Second},
+		{2, 2 * time.Second},
+		{3, 4 * time.Second},
+		{4, 5 * time.Second},
+		{100, 5 * time.Second},
+	}
+
+	for _, tc := range testCases {
+		mainTest.Run(fmt.Sprintf("attempt %d", tc.attempt), func(tt *testing.T) {
+			if got := b.delay(tc.attempt); got != tc.expected {
+				tt.Errorf("want %s, got %s", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestWithReconnect(mainTest *testing.T) {
+	testCases := []struct {
+		name     string
+		arg      Backoff
+		expected Backoff
+	}{
+		{"zero value", Backoff{}, Backoff{Min: DefaultBackoff.Min, Max: DefaultBackoff.Min}},
+		{"negative min", Backoff{Min: -time.Second, Max: time.Minute}, Backoff{Min: DefaultBackoff.Min, Max: time.Minute}},
+		{"max under min", Backoff{Min: 5 * time.Second, Max: time.Second, MaxAttempts: 3}, Backoff{Min: 5 * time.Second, Max: 5 * time.Second, MaxAttempts: 3}},
+		{"valid", DefaultBackoff, DefaultBackoff},
+	}
+
+	for _, tc := range testCases {
+		mainTest.Run(tc.name, func(t *testing.T) {
+			var w WS
+			WithReconnect(tc.arg)(&w)
+
+			if *w.backoff != tc.expected {
+				t.Errorf("want %+v, got %+v", tc.expected, *w.backoff)
+			}
+
+			if d := w.backoff.delay(1); d <= 0 {
+				t.Errorf("first attempt should wait, got %s", d)
+			}
+		})
+	}
+}
+
+func TestReconnectToken(t *testing.T) {
+	c, ctx, calls := mockTokenServer(t)
+	s := &WS{ctx: ctx, h: c, refreshToken: "refresh"}
+
+	tkn, err := s.token()
+	if err != nil {
+		t.Fatalf("first connect should authenticate, got %s", err)
+	}
+
+	// logged in now, so a reconnect should use the client's token as is
+	s.accessToken = tkn.AccessToken
+	again, err := s.token()
+	if err != nil {
+		t.Fatalf("should not fail, got %s", err)
+	}
+
+	if n := calls.Load(); n != 1 || again.AccessToken != tkn.AccessToken {
+		t.Errorf("reconnect should reuse the token source, got %d token fetches and token %s", n, again.AccessToken)
+	}
+}
+
+func TestReconnectGivesUp(t *testing.T) {
+	watchCtx, stop := context.WithCancel(context.Background())
+	defer stop()
+
+	var events []LifecycleEvent
+	s := &WS{
+		ctx:              context.Background(),
+		h:                &HTTPClient{logger: slog.New(slog.DiscardHandler)},
+		backoff:          &Backoff{Min: time.Millisecond, MaxAttempts: 1},
+		logger:           slog.New(slog.DiscardHandler),
+		errHandler:       func(error) {},
+		lifecycleHandler: func(l Lifecycle) { events = append(events, l.Event) },
+		stopTokenWatch:   stop,
+	}
+
+	// no refresh token, so the only attempt fails
+	s.reconnect(net.ErrClosed)
+
+	if n := len(events); n == 0 || events[n-1] != LifecycleEventReconnectFailed {
+		t.Fatalf("should give up after one attempt, got %v", events)
+	}
+
+	if watchCtx.Err() == nil {
+		t.Errorf("giving up should stop the token watcher")
+	}
+}
+
+func TestResubscribe(t *testing.T) {
+	ok, err := json.Marshal(WSResp{Code: WSRespCodeSucceededCommandSubs, Msg: "SUBS command succeeded"})
+	if err != nil {
+		t.Fatalf("should not fail marshal: %s", err)
+	}
+
+	var written []streamRequest
+	s := &WS{
+		ctx:     context.Background(),
+		connCtx: context.Background(),
+		logger:  slog.New(slog.DiscardHandler),
+		fm: fanoutMock{
+			requestFn: func() *socketReq {
+				r := &socketReq{c: make(chan *apiResp, 1), deadline: time.Now().Add(time.Second)}
+				r.c <- &apiResp{Content: ok}
+				return r
+			},
+		},
+		ws: socketConnMock{
+			WriteFn: func(ctx context.Context, typ websocket.MessageType, p []byte) error {
+				var x streamRequest
+				if err := json.Unmarshal(p, &x); err != nil {
+					return err
+				}
+
+				written = append(written, x)
+				return nil
+			},
+		},
+	}
+
+	s.subs.apply(serviceLeveloneEquities, commandSubs, &EquityReq{Symbols: []string{"AAPL", "MSFT"}, Fields: []EquityField{EquityFieldSymbol}})
+	s.subs.apply(serviceLeveloneEquities, commandAdd, &EquityReq{Symbols: []string{"SPY", "AAPL"}})
+	s.subs.apply(serviceLeveloneEquities, commandUnsubs, &EquityReq{Symbols: []string{"MSFT"}})
+	s.subs.apply(serviceLeveloneEquities, commandView, &EquityReq{Fields: []EquityField{EquityFieldSymbol, EquityFieldBidPrice}})
+
+	if err := s.resubscribe(); err != nil {
+		t.Fatalf("resubscribe should not fail: %s", err)
+	}
+
+	if len(written) != 1 {
+		t.Fatalf("expected exactly 1 SUBS to be replayed, got %d", len(written))
+	}
+
+	want := map[string]any{"keys": "AAPL,SPY", "fields": "0,1"}
+	got := written[0]
+	if got.Service != serviceLeveloneEquities || got.Command != commandSubs {
+		t.Errorf("wrong service/command replayed: %v %v", got.Service, got.Command)
+	}
+
+	params, _ := got.Parameters.(map[string]any)
+	if fmt.Sprint(params) != fmt.Sprint(want) {
+		t.Errorf("want params %v, got %v", want, got.Parameters)
+	}
+}
@@ -0,0 +1,372 @@
+package td
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	ErrInvalidScreenerKey = errors.New("invalid screener key")
+	ErrMissingScreenerKey = errors.New("missing screener key")
+)
+
+// ScreenerIndex is what a screener (or the movers endpoint) ranks symbols from
+type ScreenerIndex byte
+
+const (
+	ScreenerIndexUnspecified ScreenerIndex = iota
+	ScreenerIndexCompx                     // $COMPX
+	ScreenerIndexDJI                       // $DJI
+	ScreenerIndexSPX                       // $SPX
+	ScreenerIndexAll                       // INDEX_ALL
+	ScreenerIndexNYSE                      // NYSE
+	ScreenerIndexNasdaq                    // NASDAQ
+	ScreenerIndexOTCBB                     // OTCBB
+	ScreenerIndexEquityAll                 // EQUITY_ALL
+	ScreenerIndexOptionPut                 // OPTION_PUT
+	ScreenerIndexOptionCall                // OPTION_CALL
+	ScreenerIndexOptionAll                 // OPTION_ALL
+)
+
+var screenerIndexNames = [...]string{
+	ScreenerIndexCompx:      "$COMPX",
+	ScreenerIndexDJI:        "$DJI",
+	ScreenerIndexSPX:        "$SPX",
+	ScreenerIndexAll:        "INDEX_ALL",
+	ScreenerIndexNYSE:       "NYSE",
+	ScreenerIndexNasdaq:     "NASDAQ",
+	ScreenerIndexOTCBB:      "OTCBB",
+	ScreenerIndexEquityAll:  "EQUITY_ALL",
+	ScreenerIndexOptionPut:  "OPTION_PUT",
+	ScreenerIndexOptionCall: "OPTION_CALL",
+	ScreenerIndexOptionAll:  "OPTION_ALL",
+}
+
+func (s ScreenerIndex) String() string {
+	if s == ScreenerIndexUnspecified || int(s) >= len(screenerIndexNames) {
+		return fmt.Sprintf("ScreenerIndex(%d)", s)
+	}
+
+	return screenerIndexNames[s]
+}
+
+// IsOption reports whether the index ranks options rather than equities
+func (s ScreenerIndex) IsOption() bool {
+	switch s {
+	case ScreenerIndexOptionPut, ScreenerIndexOptionCall, ScreenerIndexOptionAll:
+		return true
+	default:
+		return false
+	}
+}
+
+func (s ScreenerIndex) MarshalText() ([]byte, error) {
+	if s == ScreenerIndexUnspecified || int(s) >= len(screenerIndexNames) {
+		return nil, fmt.Errorf("invalid screener index %d", s)
+	}
+
+	return []byte(s.String()), nil
+}
+
+func (s *ScreenerIndex) UnmarshalText(b []byte) error {
+	for i, v := range screenerIndexNames {
+		if v != "" && strings.EqualFold(v, string(b)) {
+			*s = ScreenerIndex(i)
+			return nil
+		}
+	}
+
+	return fmt.Errorf("invalid screener index %s", b)
+}
+
+//go:generate enumer -type ScreenerSort -trimprefix ScreenerSort -json -transform snake-upper
+type ScreenerSort byte
+
+const (
+	ScreenerSortUnspecified ScreenerSort = iota
+	ScreenerSortVolume
+	ScreenerSortTrades
+	ScreenerSortPercentChangeUp
+	ScreenerSortPercentChangeDown
+	ScreenerSortAveragePercentVolume
+)
+
+// How far back a screener looks, in minutes. The zero value is the whole day
+//
+//go:generate enumer -type ScreenerFrequency -trimprefix ScreenerFrequency
+type ScreenerFrequency byte
+
+const (
+	ScreenerFrequencyDay   ScreenerFrequency = 0
+	ScreenerFrequency1Min  ScreenerFrequency = 1
+	ScreenerFrequency5Min  ScreenerFrequency = 5
+	ScreenerFrequency10Min ScreenerFrequency = 10
+	ScreenerFrequency30Min ScreenerFrequency = 30
+	ScreenerFrequency60Min ScreenerFrequency = 60
+)
+
+// ScreenerKey identifies a screen. Schwab-standard format:
+// (index)_(sort)_(frequency), e.g. $SPX_PERCENT_CHANGE_UP_60
+type ScreenerKey struct {
+	Index     ScreenerIndex
+	Sort      ScreenerSort
+	Frequency ScreenerFrequency
+}
+
+func (s ScreenerKey) String() string {
+	return fmt.Sprintf("%s_%s_%d", s.Index, s.Sort, s.Frequency)
+}
+
+func (s ScreenerKey) Validate() error {
+	switch {
+	case s.Index == ScreenerIndexUnspecified || int(s.Index) >= len(screenerIndexNames):
+		return fmt.Errorf("%w: invalid index %d", ErrInvalidScreenerKey, s.Index)
+	case s.Sort == ScreenerSortUnspecified || !s.Sort.IsAScreenerSort():
+		return fmt.Errorf("%w: invalid sort %d", ErrInvalidScreenerKey, s.Sort)
+	case !s.Frequency.IsAScreenerFrequency():
+		return fmt.Errorf("%w: invalid frequency %d", ErrInvalidScreenerKey, s.Frequency)
+	default:
+		return nil
+	}
+}
+
+func (s *ScreenerKey) UnmarshalText(b []byte) error {
+	x := string(b)
+	idx := strings.LastIndex(x, "_")
+	if idx == -1 {
+		return fmt.Errorf("%w: %s", ErrInvalidScreenerKey, x)
+	}
+
+	freq, err := strconv.ParseUint(x[idx+1:], 10, 8)
+	if err != nil {
+		return fmt.Errorf("%w: invalid frequency in %s: %w", ErrInvalidScreenerKey, x, err)
+	}
+	s.Frequency = ScreenerFrequency(freq)
+
+	x = x[:idx]
+	for i, v := range screenerIndexNames {
+		if v == "" || !strings.HasPrefix(x, v+"_") {
+			continue
+		}
+
+		s.Index = ScreenerIndex(i)
+		if s.Sort, err = ScreenerSortString(x[len(v)+1:]); err != nil {
+			return fmt.Errorf("%w: %w", ErrInvalidScreenerKey, err)
+		}
+
+		return nil
+	}
+
+	return fmt.Errorf("%w: unknown index in %s", ErrInvalidScreenerKey, x)
+}
+
+//go:generate enumer -type ScreenerField -trimprefix ScreenerField
+type ScreenerField byte
+
+const (
+	ScreenerFieldSymbol    ScreenerField = iota // The screener key
+	ScreenerFieldTimestamp                      // Milliseconds since epoch
+	ScreenerFieldSortField                      // Field the items are sorted by
+	ScreenerFieldFrequency                      // Frequency of the screen in minutes
+	ScreenerFieldItems                          // The ranked symbols
+)
+
+// A single ranked symbol within a screen
+type ScreenerItem struct {
+	Symbol           string  `json:"symbol"`
+	Description      string  `json:"description"`
+	LastPrice        float64 `json:"lastPrice"`
+	MarketShare      float64 `json:"marketShare"` // Percent of the index's volume
+	NetChange        float64 `json:"netChange"`
+	NetPercentChange float64 `json:"netPercentChange"`
+	TotalVolume      int64   `json:"totalVolume"`
+	Trades           int64   `json:"trades"`
+	Volume           int64   `json:"volume"`
+}
+
+type Screener struct {
+	Key       ScreenerKey
+	Time      time.Time
+	Sort      ScreenerSort
+	Frequency ScreenerFrequency
+	Items     []ScreenerItem
+}
+
+func (s *Screener) UnmarshalJSON(b []byte) error {
+	type screener struct {
+		Key       string            `json:"key"`
+		Symbol    string            `json:"0"`
+		Timestamp int64             `json:"1"` // milliseconds since epoch
+		Sort      ScreenerSort      `json:"2"`
+		Frequency ScreenerFrequency `json:"3"`
+		Items     []ScreenerItem    `json:"4"`
+	}
+
+	var x screener
+	if err := json.Unmarshal(b, &x); err != nil {
+		return err
+	}
+
+	if x.Symbol == "" {
+		x.Symbol = x.Key
+	}
+
+	var key ScreenerKey
+	if err := key.UnmarshalText([]byte(x.Symbol)); err != nil {
+		return err
+	}
+
+	*s = Screener{
+		Key:       key,
+		Time:      time.UnixMilli(x.Timestamp),
+		Sort:      x.Sort,
+		Frequency: x.Frequency,
+		Items:     x.Items,
+	}
+	return nil
+}
+
+type ScreenerReq struct {
+	Keys   []ScreenerKey
+	Fields []ScreenerField
+}
+
+func (r *ScreenerReq) fields() (string, error) {
+	var sb strings.Builder
+	n := len(r.Fields) - 1
+	for i, v := range r.Fields {
+		if !v.IsAScreenerField() {
+			return "", fmt.Errorf("%s is not a screener field", v)
+		}
+
+		sb.WriteString(fmt.Sprintf("%d", int(v)))
+		if i != n {
+			sb.WriteRune(',')
+		}
+	}
+
+	return sb.String(), nil
+}
+
+func (r *ScreenerReq) MarshalJSON() ([]byte, error) {
+	s := subscribeRequest{}
+	if len(r.Fields) > 0 {
+		var err error
+		if s.Fields, err = r.fields(); err != nil {
+			return nil, err
+		}
+	}
+
+	keys := make([]string, len(r.Keys))
+	for i, v := range r.Keys {
+		if err := v.Validate(); err != nil {
+			return nil, fmt.Errorf("error at key index %d: %w", i, err)
+		}
+
+		keys[i] = v.String()
+	}
+
+	s.Keys = strings.Join(keys, ",")
+	return json.Marshal(s)
+}
+
+func (r *ScreenerReq) registryKeys() []registryKey { return stringerKeys(r.Keys) }
+func (r *ScreenerReq) registryFields() []int       { return fieldInts(r.Fields) }
+
+// keys can only go to the service matching their index
+func screenerKeysFor(svc service, keys []ScreenerKey) error {
+	if len(keys) == 0 {
+		return ErrMissingScreenerKey
+	}
+
+	for i, v := range keys {
+		if v.Index.IsOption() != (svc == serviceScreenerOption) {
+			return fmt.Errorf("%w: key %s at index %d is for the wrong screener service", ErrInvalidScreenerKey, v, i)
+		}
+	}
+
+	return nil
+}
+
+func (s *WS) screenerSubs(ctx context.Context, svc service, subs *ScreenerReq) (*WSResp, error) {
+	if len(subs.Fields) == 0 {
+		return nil, ErrMissingField
+	}
+
+	if err := screenerKeysFor(svc, subs.Keys); err != nil {
+		return nil, err
+	}
+
+	return s.genericReq(ctx, svc, commandSubs, subs)
+}
+
+func (s *WS) screenerAdd(ctx context.Context, svc service, subs *ScreenerReq) (*WSResp, error) {
+	if err := screenerKeysFor(svc, subs.Keys); err != nil {
+		return nil, err
+	}
+
+	return s.genericReq(ctx, svc, commandAdd, subs)
+}
+
+func (s *WS) screenerView(ctx context.Context, svc service, fields []ScreenerField) (*WSResp, error) {
+	if len(fields) == 0 {
+		return nil, ErrMissingField
+	}
+
+	return s.genericReq(ctx, svc, commandView, &ScreenerReq{Fields: fields})
+}
+
+func (s *WS) screenerUnsubs(ctx context.Context, svc service, keys []ScreenerKey) (*WSResp, error) {
+	if err := screenerKeysFor(svc, keys); err != nil {
+		return nil, err
+	}
+
+	return s.genericReq(ctx, svc, commandUnsubs, &ScreenerReq{Keys: keys})
+}
+
+// This uses the SUBS command to subscribe to equity screens. Using this command, you reset your subscriptions to include only this
+// set of keys and fields
+func (s *WS) SetEquityScreenerSubscription(ctx context.Context, subs *ScreenerReq) (*WSResp, error) {
+	return s.screenerSubs(ctx, serviceScreenerEquity, subs)
+}
+
+// This uses the ADD command to add additional equity screens to the subscription list, if any exist.
+// If none exist, then this will create them. If you are creating subscriptions for the first time,
+// you will need to provide a value for subs.Fields, otherwise it's not required
+func (s *WS) AddEquityScreenerSubscription(ctx context.Context, subs *ScreenerReq) (*WSResp, error) {
+	return s.screenerAdd(ctx, serviceScreenerEquity, subs)
+}
+
+func (s *WS) SetEquityScreenerSubscriptionView(ctx context.Context, fields ...ScreenerField) (*WSResp, error) {
+	return s.screenerView(ctx, serviceScreenerEquity, fields)
+}
+
+func (s *WS) UnsubEquityScreenerSubscription(ctx context.Context, keys ...ScreenerKey) (*WSResp, error) {
+	return s.screenerUnsubs(ctx, serviceScreenerEquity, keys)
+}
+
+// This uses the SUBS command to subscribe to option screens (OPTION_PUT, OPTION_CALL, OPTION_ALL).
+// Using this command, you reset your subscriptions to include only this set of keys and fields
+func (s *WS) SetOptionScreenerSubscription(ctx context.Context, subs *ScreenerReq) (*WSResp, error) {
+	return s.screenerSubs(ctx, serviceScreenerOption, subs)
+}
+
+// This uses the ADD command to add additional option screens to the subscription list, if any exist.
+// If none exist, then this will create them. If you are creating subscriptions for the first time,
+// you will need to provide a value for subs.Fields, otherwise it's not required
+func (s *WS) AddOptionScreenerSubscription(ctx context.Context, subs *ScreenerReq) (*WSResp, error) {
+	return s.screenerAdd(ctx, serviceScreenerOption, subs)
+}
+
+func (s *WS) SetOptionScreenerSubscriptionView(ctx context.Context, fields ...ScreenerField) (*WSResp, error) {
+	return s.screenerView(ctx, serviceScreenerOption, fields)
+}
+
+func (s *WS) UnsubOptionScreenerSubscription(ctx context.Context, keys ...ScreenerKey) (*WSResp, error) {
+	return s.screenerUnsubs(ctx, serviceScreenerOption, keys)
+}
@@ -0,0 +1,69 @@
+package td
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestScreenerKey(mainTest *testing.T) {
+	testCases := []struct {
+		arg      string
+		expected ScreenerKey
+	}{
+		{"$SPX_PERCENT_CHANGE_UP_60", ScreenerKey{ScreenerIndexSPX, ScreenerSortPercentChangeUp, ScreenerFrequency60Min}},
+		{"EQUITY_ALL_VOLUME_0", ScreenerKey{ScreenerIndexEquityAll, ScreenerSortVolume, ScreenerFrequencyDay}},
+		{"OPTION_CALL_AVERAGE_PERCENT_VOLUME_5", ScreenerKey{ScreenerIndexOptionCall, ScreenerSortAveragePercentVolume, ScreenerFrequency5Min}},
+	}
+
+	for _, tc := range testCases {
+		mainTest.Run(tc.arg, func(tt *testing.T) {
+			var x ScreenerKey
+			if err := x.UnmarshalText([]byte(tc.arg)); err != nil {
+				tt.Errorf("test should not fail, got %s", err)
+				return
+			}
+
+			if x != tc.expected {
+				tt.Errorf("want %+v, got %+v", tc.expected, x)
+			}
+
+			if got := x.String(); got != tc.arg {
+				tt.Errorf("should round trip to %s, got %s", tc.arg, got)
+			}
+		})
+	}
+}
+
+func TestScreenerUnmarshal(t *testing.T) {
+	arg := `{"key":"NASDAQ_VOLUME_30","1":1742275584551,"2":"VOLUME","3":30,"4":[
+{"description":"NVIDIA CORP","lastPrice":118.53,"marketShare":4.63,"netChange":-0.99,"netPercentChange":-0.0083,"symbol":"NVDA","totalVolume":245190612,"trades":1118,"volume":11358493}]}`
+
+	want := Screener{
+		Key:       ScreenerKey{ScreenerIndexNasdaq, ScreenerSortVolume, ScreenerFrequency30Min},
+		Time:      time.UnixMilli(1742275584551),
+		Sort:      ScreenerSortVolume,
+		Frequency: ScreenerFrequency30Min,
+		Items: []ScreenerItem{{
+			Symbol:           "NVDA",
+			Description:      "NVIDIA CORP",
+			LastPrice:        118.53,
+			MarketShare:      4.63,
+			NetChange:        -0.99,
+			NetPercentChange: -0.0083,
+			TotalVolume:      245190612,
+			Trades:           1118,
+			Volume:           11358493,
+		}},
+	}
+
+	var got Screener
+	if err := json.Unmarshal([]byte(arg), &got); err != nil {
+		t.Fatalf("should not fail unmarshal: %s", err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("want %+v\ngot  %+v", want, got)
+	}
+}
@@ -0,0 +1,306 @@
+package td
+
+import (
+	"encoding/json"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Subscription is a snapshot of what the server has for a single streaming service
+type Subscription struct {
+	Service string   // Schwab's name for the service, e.g. LEVELONE_EQUITIES
+	Keys    []string // Keys exactly as they were sent to the server, in subscription order
+	Fields  []int    // Field numbers currently in view
+}
+
+// registryKey is a key as sent over the wire and the typed value it came from
+type registryKey struct {
+	wire string
+	id   any
+}
+
+// registryReq is implemented by every subscription request type so the
+// registry can record exactly what the server acknowledged
+type registryReq interface {
+	registryKeys() []registryKey
+	registryFields() []int
+}
+
+func stringKeys(s []string) []registryKey {
+	k := make([]registryKey, len(s))
+	for i, v := range s {
+		k[i] = registryKey{wire: v, id: v}
+	}
+
+	return k
+}
+
+// stringerKeys takes IDs whose String, on a pointer or not, is their wire format
+func stringerKeys[K any, P interface {
+	*K
+	String() string
+}](s []K) []registryKey {
+	k := make([]registryKey, len(s))
+	for i, v := range s {
+		k[i] = registryKey{wire: P(&v).String(), id: v}
+	}
+
+	return k
+}
+
+func fieldInts[F ~uint8](s []F) []int {
+	x := make([]int, len(s))
+	for i, v := range s {
+		x[i] = int(v)
+	}
+
+	return x
+}
+
+type subscription struct {
+	keys   []string
+	ids    map[string]any
+	fields []int
+}
+
+func (s subscription) clone() subscription {
+	c := subscription{
+		keys:   slices.Clone(s.keys),
+		ids:    make(map[string]any, len(s.ids)),
+		fields: slices.Clone(s.fields),
+	}
+
+	for k, v := range s.ids {
+		c.ids[k] = v
+	}
+
+	return c
+}
+
+func (s subscription) req() subscribeRequest {
+	f := make([]string, len(s.fields))
+	for i, v := range s.fields {
+		f[i] = strconv.Itoa(v)
+	}
+
+	return subscribeRequest{Keys: strings.Join(s.keys, ","), Fields: strings.Join(f, ",")}
+}
+
+// subscriptions mirrors server side subscription state per service. It's only
+// updated once the server acknowledges a command, and is what gets replayed
+// after a reconnect
+type subscriptions struct {
+	mu       sync.RWMutex
+	services map[service]subscription
+
+	// held from sending a command until it's recorded, one per service
+	locksMu sync.Mutex
+	locks   map[service]*sync.Mutex
+}
+
+// lock serializes commands on svc, returning the func that releases it
+func (s *subscriptions) lock(svc service) (unlock func()) {
+	s.locksMu.Lock()
+	if s.locks == nil {
+		s.locks = map[service]*sync.Mutex{}
+	}
+
+	l, ok := s.locks[svc]
+	if !ok {
+		l = &sync.Mutex{}
+		s.locks[svc] = l
+	}
+	s.locksMu.Unlock()
+
+	l.Lock()
+	return l.Unlock
+}
+
+// apply records a command the server acknowledged
+func (s *subscriptions) apply(svc service, cmd command, params any) {
+	r, ok := params.(registryReq)
+	if !ok {
+		return
+	}
+
+	keys, fields := r.registryKeys(), r.registryFields()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.services == nil {
+		s.services = map[service]subscription{}
+	}
+
+	cur := s.services[svc].clone()
+	switch cmd {
+	case commandSubs:
+		cur = subscription{ids: make(map[string]any, len(keys)), fields: fields}
+		cur.add(keys)
+	case commandAdd:
+		cur.add(keys)
+		if len(fields) > 0 {
+			cur.fields = fields
+		}
+	case commandUnsubs:
+		for _, v := range keys {
+			delete(cur.ids, v.wire)
+		}
+
+		cur.keys = slices.DeleteFunc(cur.keys, func(k string) bool {
+			_, ok := cur.ids[k]
+			return !ok
+		})
+	case commandView:
+		cur.fields = fields
+	default:
+		return
+	}
+
+	// the server forgets the fields along with the last key
+	if len(cur.keys) == 0 {
+		delete(s.services, svc)
+		return
+	}
+
+	s.services[svc] = cur
+}
+
+func (s *subscription) add(keys []registryKey) {
+	for _, v := range keys {
+		if _, ok := s.ids[v.wire]; !ok {
+			s.keys = append(s.keys, v.wire)
+		}
+
+		s.ids[v.wire] = v.id
+	}
+}
+
+func (s *subscriptions) get(svc service) subscription {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.services[svc].clone()
+}
+
+func (s *subscriptions) snapshot() map[service]subscription {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	m := make(map[service]subscription, len(s.services))
+	for k, v := range s.services {
+		m[k] = v.clone()
+	}
+
+	return m
+}
+
+func registryIDs[X any](s subscription) []X {
+	x := make([]X, 0, len(s.keys))
+	for _, k := range s.keys {
+		if v, ok := s.ids[k].(X); ok {
+			x = append(x, v)
+		}
+	}
+
+	return x
+}
+
+func registryFields[X ~uint8](s subscription) []X {
+	x := make([]X, len(s.fields))
+	for i, v := range s.fields {
+		x[i] = X(v)
+	}
+
+	return x
+}
+
+// Subscriptions returns a snapshot of every service with active subscriptions,
+// as acknowledged by the server. Diff two snapshots to audit changes over time
+func (s *WS) Subscriptions() []Subscription {
+	m := s.subs.snapshot()
+	svcs := make([]service, 0, len(m))
+	for k := range m {
+		svcs = append(svcs, k)
+	}
+	slices.Sort(svcs)
+
+	subs := make([]Subscription, len(svcs))
+	for i, svc := range svcs {
+		name, _ := json.Marshal(svc)
+		subs[i] = Subscription{
+			Service: strings.Trim(string(name), `"`),
+			Keys:    m[svc].keys,
+			Fields:  m[svc].fields,
+		}
+	}
+
+	return subs
+}
+
+// EquitySubscriptions returns the equities currently subscribed to and the fields in view
+func (s *WS) EquitySubscriptions() EquityReq {
+	x := s.subs.get(serviceLeveloneEquities)
+	return EquityReq{Symbols: registryIDs[string](x), Fields: registryFields[EquityField](x)}
+}
+
+// OptionSubscriptions returns the options currently subscribed to and the fields in view
+func (s *WS) OptionSubscriptions() OptionReq {
+	x := s.subs.get(serviceLeveloneOptions)
+	return OptionReq{Options: registryIDs[OptionID](x), Fields: registryFields[OptionField](x)}
+}
+
+// FutureSubscriptions returns the futures currently subscribed to and the fields in view
+func (s *WS) FutureSubscriptions() FutureReq {
+	x := s.subs.get(serviceLeveloneFutures)
+	return FutureReq{Symbols: registryIDs[FutureID](x), Fields: registryFields[FutureField](x)}
+}
+
+// FutureOptionSubscriptions returns the futures options currently subscribed to and the fields in view
+func (s *WS) FutureOptionSubscriptions() FutureOptionReq {
+	x := s.subs.get(serviceLeveloneFuturesOptions)
+	return FutureOptionReq{Symbols: registryIDs[FutureOptionID](x), Fields: registryFields[FutureOptionField](x)}
+}
+
+// ForexSubscriptions returns the currency pairs currently subscribed to and the fields in view
+func (s *WS) ForexSubscriptions() ForexReq {
+	x := s.subs.get(serviceLeveloneForex)
+	return ForexReq{Symbols: registryIDs[string](x), Fields: registryFields[ForexField](x)}
+}
+
+// BookSubscriptions returns the symbols currently subscribed to on the book of type t and the fields in view
+func (s *WS) BookSubscriptions(t BookType) BookReq {
+	svc, err := t.service()
+	if err != nil {
+		return BookReq{}
+	}
+
+	x := s.subs.get(svc)
+	return BookReq{Symbols: registryIDs[string](x), Fields: registryFields[BookField](x)}
+}
+
+// EquityScreenerSubscriptions returns the equity screens currently subscribed to and the fields in view
+func (s *WS) EquityScreenerSubscriptions() ScreenerReq {
+	x := s.subs.get(serviceScreenerEquity)
+	return ScreenerReq{Keys: registryIDs[ScreenerKey](x), Fields: registryFields[ScreenerField](x)}
+}
+
+// OptionScreenerSubscriptions returns the option screens currently subscribed to and the fields in view
+func (s *WS) OptionScreenerSubscriptions() ScreenerReq {
+	x := s.subs.get(serviceScreenerOption)
+	return ScreenerReq{Keys: registryIDs[ScreenerKey](x), Fields: registryFields[ScreenerField](x)}
+}
+
+// ChartEquitySubscriptions returns the equity charts currently subscribed to and the fields in view
+func (s *WS) ChartEquitySubscriptions() ChartEquityReq {
+	x := s.subs.get(serviceChartEquity)
+	return ChartEquityReq{Symbols: registryIDs[string](x), Fields: registryFields[ChartEquityField](x)}
+}
+
+// ChartFutureSubscriptions returns the futures charts currently subscribed to and the fields in view
+func (s *WS) ChartFutureSubscriptions() ChartFutureReq {
+	x := s.subs.get(serviceChartFutures)
+	return ChartFutureReq{Symbols: registryIDs[string](x), Fields: registryFields[ChartFutureField](x)}
+}
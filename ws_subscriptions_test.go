@@ -0,0 +1,168 @@
+package td
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/coder/websocket"
+)
+
+func mockRespWS(t *testing.T, code WSRespCode) *WS {
+	buf, err := json.Marshal(WSResp{Code: code})
+	if err != nil {
+		t.Fatalf("should not fail marshal: %s", err)
+	}
+
+	return &WS{
+		ctx:     context.Background(),
+		connCtx: context.Background(),
+		logger:  slog.New(slog.DiscardHandler),
+		fm: fanoutMock{
+			requestFn: func() *socketReq {
+				r := &socketReq{c: make(chan *apiResp, 1), deadline: time.Now().Add(time.Second)}
+				r.c <- &apiResp{Content: buf}
+				return r
+			},
+		},
+		ws: socketConnMock{
+			WriteFn: func(ctx context.Context, typ websocket.MessageType, p []byte) error { return nil },
+		},
+	}
+}
+
+func TestSubscriptionRegistry(t *testing.T) {
+	ctx := context.Background()
+	s := mockRespWS(t, WSRespCodeSucceededCommandSubs)
+
+	es := FutureID{Symbol: "ES", Month: time.March, Year: 26}
+	nq := FutureID{Symbol: "NQ", Month: time.March, Year: 26}
+	if _, err := s.SetFutureSubscription(ctx, &FutureReq{
+		Symbols: []FutureID{es, nq},
+		Fields:  []FutureField{FutureFieldSymbol, FutureFieldBidPrice},
+	}); err != nil {
+		t.Fatalf("should not fail: %s", err)
+	}
+
+	s.fm = mockRespWS(t, WSRespCodeSucceededCommandUnsubs).fm
+	if _, err := s.UnsubFutureSubscription(ctx, nq); err != nil {
+		t.Fatalf("should not fail: %s", err)
+	}
+
+	s.fm = mockRespWS(t, WSRespCodeFailedCommandAdd).fm
+	if _, err := s.AddFutureSubscription(ctx, &FutureReq{Symbols: []FutureID{nq}}); err != nil {
+		t.Fatalf("should not fail: %s", err)
+	}
+
+	want := FutureReq{Symbols: []FutureID{es}, Fields: []FutureField{FutureFieldSymbol, FutureFieldBidPrice}}
+	if got := s.FutureSubscriptions(); !reflect.DeepEqual(got, want) {
+		t.Errorf("failed ADD should not be recorded\nwant %+v\ngot  %+v", want, got)
+	}
+
+	if got := s.EquitySubscriptions(); len(got.Symbols) != 0 || len(got.Fields) != 0 {
+		t.Errorf("should have no equity subscriptions, got %+v", got)
+	}
+
+	wantSnapshot := []Subscription{{Service: "LEVELONE_FUTURES", Keys: []string{es.String()}, Fields: []int{0, 1}}}
+	if got := s.Subscriptions(); !reflect.DeepEqual(got, wantSnapshot) {
+		t.Errorf("want %+v\ngot  %+v", wantSnapshot, got)
+	}
+}
+
+func TestSubscriptionRegistryOrder(t *testing.T) {
+	ctx := context.Background()
+
+	resps := make(chan chan *apiResp, 2)
+	writes := make(chan command, 2)
+	s := mockRespWS(t, WSRespCodeSucceededCommandSubs)
+	s.fm = fanoutMock{
+		requestFn: func() *socketReq {
+			r := &socketReq{c: make(chan *apiResp, 1), deadline: time.Now().Add(time.Second)}
+			resps <- r.c
+			return r
+		},
+	}
+	s.ws = socketConnMock{
+		WriteFn: func(ctx context.Context, typ websocket.MessageType, p []byte) error {
+			var r struct{ Command command }
+			if err := json.Unmarshal(p, &r); err != nil {
+				t.Errorf("should not fail unmarshal: %s", err)
+			}
+
+			writes <- r.Command
+			return nil
+		},
+	}
+
+	respond := func(code WSRespCode) {
+		buf, err := json.Marshal(WSResp{Code: code})
+		if err != nil {
+			t.Fatalf("should not fail marshal: %s", err)
+		}
+
+		(<-resps) <- &apiResp{Content: buf}
+	}
+
+	done := make(chan error, 2)
+	go func() {
+		_, err := s.SetEquitySubscription(ctx, &EquityReq{Symbols: []string{"A"}, Fields: []EquityField{EquityFieldSymbol}})
+		done <- err
+	}()
+
+	if cmd := <-writes; cmd != commandSubs {
+		t.Fatalf("should have written SUBS first, got %s", cmd)
+	}
+
+	go func() {
+		_, err := s.AddEquitySubscription(ctx, &EquityReq{Symbols: []string{"B"}})
+		done <- err
+	}()
+
+	select {
+	case cmd := <-writes:
+		t.Fatalf("should not write %s before SUBS is acknowledged", cmd)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	respond(WSRespCodeSucceededCommandSubs)
+	if cmd := <-writes; cmd != commandAdd {
+		t.Fatalf("should have written ADD after SUBS, got %s", cmd)
+	}
+
+	respond(WSRespCodeSucceededCommandAdd)
+	for range 2 {
+		if err := <-done; err != nil {
+			t.Fatalf("should not fail: %s", err)
+		}
+	}
+
+	want := EquityReq{Symbols: []string{"A", "B"}, Fields: []EquityField{EquityFieldSymbol}}
+	if got := s.EquitySubscriptions(); !reflect.DeepEqual(got, want) {
+		t.Errorf("want %+v\ngot  %+v", want, got)
+	}
+}
+
+func TestSubscriptionRegistryUnsubsLastKey(t *testing.T) {
+	ctx := context.Background()
+	s := mockRespWS(t, WSRespCodeSucceededCommandSubs)
+
+	if _, err := s.SetAccountActivitySubscription(ctx); err != nil {
+		t.Fatalf("should not fail: %s", err)
+	}
+
+	if got := s.Subscriptions(); len(got) != 1 {
+		t.Fatalf("should have account activity subscribed, got %+v", got)
+	}
+
+	s.fm = mockRespWS(t, WSRespCodeSucceededCommandUnsubs).fm
+	if _, err := s.UnsubAccountActivitySubscription(ctx); err != nil {
+		t.Fatalf("should not fail: %s", err)
+	}
+
+	if got := s.Subscriptions(); len(got) != 0 {
+		t.Errorf("service should be dropped with its last key, got %+v", got)
+	}
+}
@@ -0,0 +1,101 @@
+package td
+
+import (
+	"context"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+const (
+	// How long before the access token expires the socket asks the HTTPClient for
+	// it. That's inside oauth2's own expiry window, so asking is what refreshes it
+	wsTokenRefreshLead = 5 * time.Second
+
+	// How long to wait before trying again when the token can't be refreshed
+	wsTokenRetry = time.Minute
+)
+
+// watchToken keeps the streaming session on a live access token. Every token the
+// HTTPClient refreshes, on its own or because of this, is used to log in again.
+// It runs until ctx is canceled, which Close and a failed reconnect do
+func (s *WS) watchToken(ctx context.Context) {
+	// logins happen one at a time from here, and a token that's refreshed while one
+	// is in flight replaces any still waiting, so the newest token always logs in last
+	tokens := make(chan string, 1)
+	unsubscribe := s.h.OnTokenRefresh(func(t oauth2.Token) {
+		for {
+			select {
+			case tokens <- t.AccessToken:
+				return
+			default:
+			}
+
+			select {
+			case <-tokens:
+			default:
+			}
+		}
+	})
+	defer unsubscribe()
+
+	for {
+		wait := wsTokenRetry
+		t, err := s.h.Token()
+		switch {
+		case err != nil:
+			s.logger.ErrorContext(ctx, "failed refreshing access token for the stream", "err", err, "retryIn", wait)
+			s.errHandler(err)
+		case t.Expiry.IsZero():
+			return // never expires
+		default:
+			wait = max(time.Until(t.Expiry)-wsTokenRefreshLead, time.Second)
+		}
+
+		timer := time.NewTimer(wait)
+	waiting:
+		for {
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return
+			case accessToken := <-tokens:
+				s.relogin(accessToken)
+			case <-timer.C:
+				break waiting
+			}
+		}
+	}
+}
+
+// relogin issues the LOGIN command again on the live connection with a new access
+// token, so the session doesn't outlive the one it logged in with. If that fails the
+// connection is dropped like any other, which reconnects when WithReconnect is on
+func (s *WS) relogin(accessToken string) {
+	s.loginMu.Lock()
+	defer s.loginMu.Unlock()
+
+	// connect logs in with its own fresh token while reconnecting
+	if accessToken == s.accessToken || s.closed.Load() || s.ctx.Err() != nil || s.reconnecting.Load() {
+		return
+	}
+
+	s.mu.RLock()
+	ctx, channel, functionID := s.connCtx, s.clientChannel, s.functionID
+	s.mu.RUnlock()
+
+	if ctx.Err() != nil {
+		return
+	}
+
+	resp, err := s.login(ctx, accessToken, channel, functionID)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed logging in with refreshed access token, dropping the connection", "err", err)
+		s.keepaliveErr(err)
+		return
+	}
+
+	s.logger.InfoContext(ctx, "logged in with refreshed access token", "type", resp.ConnStatus, "server", resp.Server)
+	s.accessToken = accessToken
+	s.loggedIn(resp)
+}
@@ -0,0 +1,277 @@
+package td
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/coder/websocket"
+	"golang.org/x/oauth2"
+)
+
+func TestReloginOnTokenRefresh(mainTest *testing.T) {
+	testCases := []struct {
+		name      string
+		code      WSRespCode
+		relogged  bool
+		connAlive bool
+	}{
+		{name: "logs in again with the new token", code: WSRespCodeSuccess, relogged: true, connAlive: true},
+		{name: "drops the connection when login fails", code: WSRespCodeLoginDenied},
+	}
+
+	for _, tc := range testCases {
+		mainTest.Run(tc.name, func(t *testing.T) {
+			var calls atomic.Int32
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				// expires inside oauth2's expiry window, so every Token call refreshes
+				fmt.Fprintf(w, `{"access_token":"access%d","token_type":"Bearer","expires_in":1,"refresh_token":"refresh"}`, calls.Add(1))
+			}))
+			defer srv.Close()
+
+			h := &HTTPClient{
+				logger:    slog.New(slog.DiscardHandler),
+				oauthConf: oauth2.Config{Endpoint: oauth2.Endpoint{TokenURL: srv.URL, AuthStyle: oauth2.AuthStyleInParams}},
+			}
+
+			ctx := context.WithValue(context.Background(), oauth2.HTTPClient, srv.Client())
+			tkn, err := h.Authenticate(ctx, "refresh")
+			if err != nil {
+				t.Fatalf("should not fail authenticating, got %s", err)
+			}
+
+			content, _ := json.Marshal(WSResp{Code: tc.code, Msg: "server=s1;status=PP"})
+			logins := make(chan streamRequest, 4)
+			errs := make(chan error, 4)
+
+			connCtx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			s := &WS{
+				ctx:           context.Background(),
+				connCtx:       connCtx,
+				cancel:        cancel,
+				h:             h,
+				accessToken:   tkn.AccessToken,
+				clientChannel: "channel",
+				functionID:    "function",
+				logger:        slog.New(slog.DiscardHandler),
+				errHandler:    func(err error) { errs <- err },
+				fm: fanoutMock{
+					requestFn: func() *socketReq {
+						r := &socketReq{c: make(chan *apiResp, 1), deadline: time.Now().Add(time.Second)}
+						r.c <- &apiResp{Content: content}
+						return r
+					},
+				},
+				ws: socketConnMock{
+					WriteFn: func(ctx context.Context, typ websocket.MessageType, p []byte) error {
+						var x streamRequest
+						if err := json.Unmarshal(p, &x); err != nil {
+							return err
+						}
+
+						logins <- x
+						return nil
+					},
+				},
+			}
+
+			watchCtx, stop := context.WithCancel(context.Background())
+			defer stop()
+
+			exited := make(chan struct{})
+			go func() {
+				s.watchToken(watchCtx)
+				close(exited)
+			}()
+
+			// the token is already inside oauth2's expiry window, so watchToken
+			// refreshes it right away and then again every second
+			waitLogin := func(want string) {
+				t.Helper()
+				select {
+				case x := <-logins:
+					params, _ := x.Parameters.(map[string]any)
+					if x.Service != serviceAdmin || x.Command != commandLogin || params["Authorization"] != want {
+						t.Errorf("want LOGIN with %s, got %+v", want, x)
+					}
+
+					if params["SchwabClientChannel"] != "channel" || params["SchwabClientFunctionId"] != "function" {
+						t.Errorf("login should reuse the stream info, got %v", params)
+					}
+				case <-time.After(2 * time.Second):
+					t.Fatalf("timed out waiting for LOGIN with %s", want)
+				}
+			}
+
+			waitLogin("access2")
+			if !tc.relogged {
+				select {
+				case <-errs:
+				case <-time.After(time.Second):
+					t.Fatal("failed login should be reported")
+				}
+			} else {
+				waitLogin("access3")
+			}
+
+			s.loginMu.Lock()
+			got := s.accessToken
+			s.loginMu.Unlock()
+
+			if want := map[bool]string{true: "access3", false: tkn.AccessToken}[tc.relogged]; got != want {
+				t.Errorf("session access token should be %s, got %s", want, got)
+			}
+
+			if tc.relogged && (s.Server() != "s1" || s.ConnStatus() != ConnStatusPro) {
+				t.Errorf("should record the new login, got server %q status %s", s.Server(), s.ConnStatus())
+			}
+
+			if alive := connCtx.Err() == nil; alive != tc.connAlive {
+				t.Errorf("connection alive should be %t, got %t", tc.connAlive, alive)
+			}
+
+			stop()
+			select {
+			case <-exited:
+			case <-time.After(time.Second):
+				t.Fatal("watchToken should return once stopped")
+			}
+
+			h.tokens.mu.Lock()
+			subs := len(h.tokens.subs)
+			h.tokens.mu.Unlock()
+
+			if subs != 0 {
+				t.Errorf("stopped watcher should unsubscribe, got %d subscribers", subs)
+			}
+
+			// no longer subscribed, so another refresh shouldn't log in again
+			if _, err = h.Token(); err != nil {
+				t.Fatalf("should not fail refreshing, got %s", err)
+			}
+
+			select {
+			case x := <-logins:
+				t.Errorf("stopped socket shouldn't log in, got %+v", x)
+			case <-time.After(50 * time.Millisecond):
+			}
+		})
+	}
+}
+
+func TestReloginNewestToken(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"access_token":"access1","token_type":"Bearer","expires_in":3600,"refresh_token":"refresh"}`)
+	}))
+	defer srv.Close()
+
+	h := &HTTPClient{
+		logger:    slog.New(slog.DiscardHandler),
+		oauthConf: oauth2.Config{Endpoint: oauth2.Endpoint{TokenURL: srv.URL, AuthStyle: oauth2.AuthStyleInParams}},
+	}
+
+	ctx := context.WithValue(context.Background(), oauth2.HTTPClient, srv.Client())
+	if _, err := h.Authenticate(ctx, "refresh"); err != nil {
+		t.Fatalf("should not fail authenticating, got %s", err)
+	}
+
+	content, _ := json.Marshal(WSResp{Code: WSRespCodeSuccess, Msg: "server=s1;status=PP"})
+	logins := make(chan string, 4)
+	release := make(chan struct{})
+
+	connCtx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	s := &WS{
+		ctx:         context.Background(),
+		connCtx:     connCtx,
+		cancel:      cancel,
+		h:           h,
+		accessToken: "access1",
+		logger:      slog.New(slog.DiscardHandler),
+		errHandler:  func(err error) { t.Errorf("should not fail, got %s", err) },
+		fm: fanoutMock{
+			requestFn: func() *socketReq {
+				r := &socketReq{c: make(chan *apiResp, 1), deadline: time.Now().Add(time.Second)}
+				r.c <- &apiResp{Content: content}
+				return r
+			},
+		},
+		ws: socketConnMock{
+			WriteFn: func(ctx context.Context, typ websocket.MessageType, p []byte) error {
+				var x streamRequest
+				if err := json.Unmarshal(p, &x); err != nil {
+					return err
+				}
+
+				params, _ := x.Parameters.(map[string]any)
+				logins <- fmt.Sprint(params["Authorization"])
+				<-release
+				return nil
+			},
+		},
+	}
+
+	watchCtx, stop := context.WithCancel(context.Background())
+	defer stop()
+	go s.watchToken(watchCtx)
+
+	waitSubscribed := time.After(time.Second)
+	for {
+		h.tokens.mu.Lock()
+		subs := len(h.tokens.subs)
+		h.tokens.mu.Unlock()
+
+		if subs > 0 {
+			break
+		}
+
+		select {
+		case <-waitSubscribed:
+			t.Fatal("watcher should subscribe to refreshes")
+		case <-time.After(time.Millisecond):
+		}
+	}
+
+	l := slog.New(slog.DiscardHandler)
+	h.tokenRefreshed(ctx, l, &oauth2.Token{AccessToken: "access2"})
+	if got := <-logins; got != "access2" {
+		t.Fatalf("should log in with access2, got %s", got)
+	}
+
+	// both refreshed while access2 is logging in, only the newest should follow it
+	h.tokenRefreshed(ctx, l, &oauth2.Token{AccessToken: "access3"})
+	h.tokenRefreshed(ctx, l, &oauth2.Token{AccessToken: "access4"})
+	close(release)
+
+	select {
+	case got := <-logins:
+		if got != "access4" {
+			t.Errorf("should log in with the newest token, got %s", got)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for LOGIN with access4")
+	}
+
+	select {
+	case got := <-logins:
+		t.Errorf("replaced token shouldn't log in, got %s", got)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	s.loginMu.Lock()
+	defer s.loginMu.Unlock()
+	if s.accessToken != "access4" {
+		t.Errorf("session should be on access4, got %s", s.accessToken)
+	}
+}